@@ -0,0 +1,58 @@
+// Command ddbcompat compares two descriptor sets and reports changes that break reading existing
+// DynamoDB items. It exits with a non-zero status if any are found, so it can be used as a CI gate:
+//
+//	buf build -o next.binpb && buf build .git#branch=main -o prev.binpb
+//	ddbcompat prev.binpb next.binpb
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb/ddbcompat"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s <prev descriptor set> <next descriptor set>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(flag.Arg(0), flag.Arg(1)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run compares the descriptor sets and prints the breaking changes
+func run(prevName, nextName string) error {
+	prev, err := ddbcompat.ReadFiles(prevName)
+	if err != nil {
+		return fmt.Errorf("failed to read previous descriptor set: %w", err)
+	}
+
+	next, err := ddbcompat.ReadFiles(nextName)
+	if err != nil {
+		return fmt.Errorf("failed to read next descriptor set: %w", err)
+	}
+
+	changes, err := ddbcompat.Compare(prev, next)
+	if err != nil {
+		return fmt.Errorf("failed to compare descriptor sets: %w", err)
+	}
+
+	for _, c := range changes {
+		fmt.Println(c)
+	}
+
+	if len(changes) > 0 {
+		return fmt.Errorf("found %d breaking change(s)", len(changes))
+	}
+	return nil
+}
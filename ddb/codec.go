@@ -0,0 +1,172 @@
+package ddb
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Codec marshals and unmarshals the value of a field with an encoding that is not provided by this
+// package, such as money as a scaled integer. Fields select a codec by the name it is registered under
+// with the (ddb.v1.field).codec option. Enums are always passed as int32 numbers (and lists and maps
+// of enums as []int32 and map[K]int32), so codecs see the same values for generated code, paths and
+// MarshalDynamic, which cannot know the Go type of an enum.
+type Codec interface {
+	// MarshalAttribute marshals field value 'v' into an attribute value
+	MarshalAttribute(v any) (types.AttributeValue, error)
+	// UnmarshalAttribute unmarshals 'av' into 'v', a pointer to the field value
+	UnmarshalAttribute(av types.AttributeValue, v any) error
+}
+
+// ErrCodecNotRegistered is returned when a field's codec is not registered
+var ErrCodecNotRegistered = errors.New("codec not registered")
+
+// codecs holds the registered codecs by name
+var codecs = struct {
+	sync.RWMutex
+	m map[string]Codec
+}{m: map[string]Codec{}}
+
+// RegisterCodec registers codec 'c' under 'name'. It panics if a codec is already registered under that
+// name, so it is usually called from an init function.
+func RegisterCodec(name string, c Codec) {
+	codecs.Lock()
+	defer codecs.Unlock()
+	if _, ok := codecs.m[name]; ok {
+		panic(fmt.Sprintf("ddb: codec '%s' is already registered", name))
+	}
+	codecs.m[name] = c
+}
+
+// LookupCodec returns the codec that is registered under 'name'
+func LookupCodec(name string) (c Codec, ok bool) {
+	codecs.RLock()
+	defer codecs.RUnlock()
+	c, ok = codecs.m[name]
+	return
+}
+
+// MarshalCodec marshals 'v' with the codec that is registered under 'name'
+func MarshalCodec(name string, v any) (types.AttributeValue, error) {
+	c, ok := LookupCodec(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrCodecNotRegistered, name)
+	}
+
+	av, err := c.MarshalAttribute(codecValue(v))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal with codec '%s': %w", name, err)
+	}
+	return av, nil
+}
+
+// UnmarshalCodec unmarshals 'av' into 'v' with the codec that is registered under 'name'
+func UnmarshalCodec(name string, av types.AttributeValue, v any) error {
+	c, ok := LookupCodec(name)
+	if !ok {
+		return fmt.Errorf("%w: '%s'", ErrCodecNotRegistered, name)
+	}
+
+	dst, set := codecTarget(v)
+	if err := c.UnmarshalAttribute(av, dst); err != nil {
+		return fmt.Errorf("failed to unmarshal with codec '%s': %w", name, err)
+	}
+	set()
+	return nil
+}
+
+// enumType is the interface of enum values
+var enumType = reflect.TypeOf((*protoreflect.Enum)(nil)).Elem()
+
+// isEnumType returns whether values of type 'rt' are enums, this includes the protoreflect.Enum interface
+func isEnumType(rt reflect.Type) bool {
+	return rt.Implements(enumType)
+}
+
+// enumNumber returns the number of enum value 'rv'
+func enumNumber(rv reflect.Value) int32 {
+	if rv.Kind() == reflect.Interface && rv.IsNil() {
+		return 0
+	}
+	return int32(rv.Interface().(protoreflect.Enum).Number())
+}
+
+// codecValue returns 'v' with enums, and lists and maps of enums, as int32 numbers
+func codecValue(v any) any {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return v
+	}
+
+	switch rt := rv.Type(); {
+	case isEnumType(rt):
+		return enumNumber(rv)
+	case rt.Kind() == reflect.Slice && isEnumType(rt.Elem()):
+		if rv.IsNil() {
+			return []int32(nil)
+		}
+		nrs := make([]int32, rv.Len())
+		for i := range nrs {
+			nrs[i] = enumNumber(rv.Index(i))
+		}
+		return nrs
+	case rt.Kind() == reflect.Map && isEnumType(rt.Elem()):
+		if rv.IsNil() {
+			return reflect.Zero(reflect.MapOf(rt.Key(), reflect.TypeOf(int32(0)))).Interface()
+		}
+		nrs := reflect.MakeMapWithSize(reflect.MapOf(rt.Key(), reflect.TypeOf(int32(0))), rv.Len())
+		for iter := rv.MapRange(); iter.Next(); {
+			nrs.SetMapIndex(iter.Key(), reflect.ValueOf(enumNumber(iter.Value())))
+		}
+		return nrs.Interface()
+	default:
+		return v
+	}
+}
+
+// codecTarget returns what a codec unmarshals into for pointer 'v'. Enums, and lists and maps of enums,
+// are unmarshalled as int32 numbers that are set on 'v' by calling 'set'.
+func codecTarget(v any) (dst any, set func()) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return v, func() {}
+	}
+
+	switch elem := rv.Elem(); {
+	case elem.Kind() == reflect.Int32 && isEnumType(elem.Type()):
+		nr := new(int32)
+		return nr, func() { elem.SetInt(int64(*nr)) }
+	case elem.Kind() == reflect.Slice && elem.Type().Elem().Kind() == reflect.Int32 && isEnumType(elem.Type().Elem()):
+		nrs := new([]int32)
+		return nrs, func() {
+			if *nrs == nil {
+				elem.Set(reflect.Zero(elem.Type()))
+				return
+			}
+			l := reflect.MakeSlice(elem.Type(), len(*nrs), len(*nrs))
+			for i, nr := range *nrs {
+				l.Index(i).SetInt(int64(nr))
+			}
+			elem.Set(l)
+		}
+	case elem.Kind() == reflect.Map && elem.Type().Elem().Kind() == reflect.Int32 && isEnumType(elem.Type().Elem()):
+		nrs := reflect.New(reflect.MapOf(elem.Type().Key(), reflect.TypeOf(int32(0))))
+		return nrs.Interface(), func() {
+			if nrs.Elem().IsNil() {
+				elem.Set(reflect.Zero(elem.Type()))
+				return
+			}
+			m := reflect.MakeMapWithSize(elem.Type(), nrs.Elem().Len())
+			for iter := nrs.Elem().MapRange(); iter.Next(); {
+				m.SetMapIndex(iter.Key(), iter.Value().Convert(elem.Type().Elem()))
+			}
+			elem.Set(m)
+		}
+	default:
+		return v, func() {}
+	}
+}
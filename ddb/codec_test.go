@@ -0,0 +1,103 @@
+package ddb_test
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb"
+	messagev1 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// prefixCodec stores strings with a prefix
+type prefixCodec struct{}
+
+func (prefixCodec) MarshalAttribute(v any) (types.AttributeValue, error) {
+	return &types.AttributeValueMemberS{Value: "p:" + v.(string)}, nil
+}
+
+func (prefixCodec) UnmarshalAttribute(av types.AttributeValue, v any) error {
+	*(v.(*string)) = av.(*types.AttributeValueMemberS).Value[2:]
+	return nil
+}
+
+// passedCodec records the value it was passed, and unmarshals into the value it holds
+type passedCodec struct{ passed, holds *any }
+
+func (c passedCodec) MarshalAttribute(v any) (types.AttributeValue, error) {
+	*c.passed = v
+	return &types.AttributeValueMemberNULL{Value: true}, nil
+}
+
+func (c passedCodec) UnmarshalAttribute(av types.AttributeValue, v any) error {
+	*c.passed = v
+	switch v := v.(type) {
+	case *int32:
+		*v = (*c.holds).(int32)
+	case *[]int32:
+		*v = (*c.holds).([]int32)
+	case *map[string]int32:
+		*v = (*c.holds).(map[string]int32)
+	}
+	return nil
+}
+
+var _ = Describe("codec registry", func() {
+	ddb.RegisterCodec("prefix", prefixCodec{})
+
+	It("should marshal and unmarshal with a registered codec", func() {
+		av, err := ddb.MarshalCodec("prefix", "foo")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(av).To(Equal(&types.AttributeValueMemberS{Value: "p:foo"}))
+
+		var out string
+		Expect(ddb.UnmarshalCodec("prefix", av, &out)).To(Succeed())
+		Expect(out).To(Equal("foo"))
+	})
+
+	It("should error for codecs that are not registered", func() {
+		var out string
+		err := ddb.UnmarshalCodec("other", &types.AttributeValueMemberS{}, &out)
+		Expect(err).To(MatchError(ddb.ErrCodecNotRegistered))
+		Expect(err).To(MatchError(ContainSubstring("'other'")))
+	})
+
+	It("should pass enums as int32 numbers", func() {
+		var passed, holds any
+		ddb.RegisterCodec("passed", passedCodec{&passed, &holds})
+
+		for _, c := range []struct{ in, exp any }{
+			{messagev1.Dirtyness_DIRTYNESS_CLEAN, int32(1)},
+			{protoreflect.Enum(messagev1.Dirtyness_DIRTYNESS_CLEAN), int32(1)},
+			{[]messagev1.Dirtyness{1, 0}, []int32{1, 0}},
+			{[]protoreflect.Enum{messagev1.Dirtyness_DIRTYNESS_CLEAN, nil}, []int32{1, 0}},
+			{[]messagev1.Dirtyness(nil), []int32(nil)},
+			{map[string]messagev1.Dirtyness{"a": 1}, map[string]int32{"a": 1}},
+			{int64(3), int64(3)},
+		} {
+			_, err := ddb.MarshalCodec("passed", c.in)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(passed).To(Equal(c.exp))
+		}
+
+		var e messagev1.Dirtyness
+		holds = int32(1)
+		Expect(ddb.UnmarshalCodec("passed", nil, &e)).To(Succeed())
+		Expect(e).To(Equal(messagev1.Dirtyness_DIRTYNESS_CLEAN))
+		Expect(passed).To(BeAssignableToTypeOf(new(int32)))
+
+		var es []messagev1.Dirtyness
+		holds = []int32{1, 0}
+		Expect(ddb.UnmarshalCodec("passed", nil, &es)).To(Succeed())
+		Expect(es).To(Equal([]messagev1.Dirtyness{1, 0}))
+
+		var em map[string]messagev1.Dirtyness
+		holds = map[string]int32{"a": 1}
+		Expect(ddb.UnmarshalCodec("passed", nil, &em)).To(Succeed())
+		Expect(em).To(Equal(map[string]messagev1.Dirtyness{"a": 1}))
+	})
+
+	It("should panic when registering a name twice", func() {
+		Expect(func() { ddb.RegisterCodec("prefix", prefixCodec{}) }).To(PanicWith("ddb: codec 'prefix' is already registered"))
+	})
+})
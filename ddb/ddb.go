@@ -31,9 +31,15 @@ func Marshal(in any, os ...Option) (types.AttributeValue, error) {
 }
 
 // Unmarshal will marshal basic types, and composite types that only hold basic types. It takes into
-// account the embed encoding option.
-func Unmarshal(av types.AttributeValue, out any, os ...Option) error {
+// account the embed encoding and set sorting options.
+func Unmarshal(av types.AttributeValue, out any, os ...Option) (err error) {
 	opts := applyOptions(os...)
+	if opts.sortSets {
+		if av, err = sortSets(av); err != nil {
+			return fmt.Errorf("failed to sort sets: %w", err)
+		}
+	}
+
 	switch opts.embedEncoding {
 	case ddbv1.Encoding_ENCODING_JSON:
 		return jsonUnmarshal(av, out)
@@ -44,6 +50,25 @@ func Unmarshal(av types.AttributeValue, out any, os ...Option) error {
 	}
 }
 
+// UnmarshalItem unmarshals attribute map 'item' into 'x'. It uses the message's UnmarshalDynamoItem
+// method if it has one, else it falls back to UnmarshalDynamic. With the SortSets option the members
+// of every set in the item are sorted first, 'item' itself is not changed.
+func UnmarshalItem(item map[string]types.AttributeValue, x proto.Message, os ...Option) (err error) {
+	opts := applyOptions(os...)
+	if opts.sortSets {
+		if item, err = sortItemSets(item); err != nil {
+			return fmt.Errorf("failed to sort sets: %w", err)
+		}
+	}
+
+	if ux, ok := x.(interface {
+		UnmarshalDynamoItem(map[string]types.AttributeValue) error
+	}); ok {
+		return ux.UnmarshalDynamoItem(item)
+	}
+	return UnmarshalDynamic(item, x)
+}
+
 var (
 	// ErrUnsupportedEmbedEncoding is returned when a unsupported embed encoding is used
 	ErrUnsupportedEmbedEncoding = fmt.Errorf("unsupported embed encoding, supports: %s %s",
@@ -0,0 +1,366 @@
+// Package ddbcompat detects changes between two versions of protobuf definitions that break reading
+// items that were written to DynamoDB by the previous version.
+package ddbcompat
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb"
+	ddbv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Kind describes the kind of breaking change
+type Kind int
+
+const (
+	// AttributeRenamed is reported when the attribute name of a field changed
+	AttributeRenamed Kind = iota + 1
+	// AttributeReused is reported when a field uses the attribute name of a different field that was removed
+	AttributeReused
+	// KeyChanged is reported when the partition or sort key of a message changed
+	KeyChanged
+	// EncodingChanged is reported when the embed encoding or the codec of a field changed
+	EncodingChanged
+	// SetChanged is reported when a field changed between a list and a set
+	SetChanged
+	// TypeChanged is reported when a field number is re-used with an incompatible type
+	TypeChanged
+)
+
+// String returns a human readable name for the kind
+func (k Kind) String() string {
+	switch k {
+	case AttributeRenamed:
+		return "attribute renamed"
+	case AttributeReused:
+		return "attribute reused"
+	case KeyChanged:
+		return "key changed"
+	case EncodingChanged:
+		return "encoding changed"
+	case SetChanged:
+		return "set changed"
+	case TypeChanged:
+		return "type changed"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(k))
+	}
+}
+
+// Change describes a single breaking change for a field
+type Change struct {
+	Kind    Kind
+	Message protoreflect.FullName
+	Field   protoreflect.Name
+	Detail  string
+}
+
+// String formats the change for reporting
+func (c Change) String() string {
+	return fmt.Sprintf("%s.%s: %s: %s", c.Message, c.Field, c.Kind, c.Detail)
+}
+
+// ReadFiles reads a serialized FileDescriptorSet, such as a buf image (buf build -o image.binpb) or the
+// output of protoc --descriptor_set_out, and resolves it into files.
+func ReadFiles(name string) (*protoregistry.Files, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read descriptor set: %w", err)
+	}
+
+	var fds descriptorpb.FileDescriptorSet
+	if err = proto.Unmarshal(b, &fds); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal descriptor set: %w", err)
+	}
+
+	files, err := (protodesc.FileOptions{AllowUnresolvable: true}).NewFiles(&fds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve descriptor set: %w", err)
+	}
+	return files, nil
+}
+
+// Compare reports the changes between the messages in 'prev' and 'next' that break reading items
+// that were written with 'prev'. Messages are matched by full name and fields by number. Removed
+// messages and fields are not reported since their attributes are simply ignored when reading. It
+// returns an error if the options of fields are malformed.
+func Compare(prev, next *protoregistry.Files) (changes []Change, err error) {
+	var errs []error
+	prevMsgs := messages(prev)
+	for name, nmd := range messages(next) {
+		if pmd, ok := prevMsgs[name]; ok {
+			mchanges, err := CompareMessages(pmd, nmd)
+			changes, errs = append(changes, mchanges...), append(errs, err)
+		}
+	}
+	if err = errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Message != changes[j].Message {
+			return changes[i].Message < changes[j].Message
+		}
+		if changes[i].Field != changes[j].Field {
+			return changes[i].Field < changes[j].Field
+		}
+		return changes[i].Kind < changes[j].Kind
+	})
+	return changes, nil
+}
+
+// CompareMessages reports the breaking changes between two versions of a single message. It returns an
+// error if the options of fields are malformed.
+func CompareMessages(prev, next protoreflect.MessageDescriptor) (changes []Change, err error) {
+	report := func(k Kind, fd protoreflect.FieldDescriptor, format string, args ...any) {
+		changes = append(changes, Change{k, next.FullName(), fd.Name(), fmt.Sprintf(format, args...)})
+	}
+
+	if err = errors.Join(validateOptions(prev), validateOptions(next)); err != nil {
+		return nil, err
+	}
+
+	prevByAttr := map[string]protoreflect.FieldDescriptor{}
+	for _, pfd := range fields(prev) {
+		prevByAttr[attrName(pfd)] = pfd
+
+		// a key field that is removed (or omitted) changes the key just as well
+		popts := fieldOptions(pfd)
+		if nfd := next.Fields().ByNumber(pfd.Number()); (nfd == nil || fieldOptions(nfd).GetOmit()) &&
+			(popts.GetPk() || popts.GetSk()) {
+			report(KeyChanged, pfd, "%s field was removed", keyName(popts))
+		}
+	}
+
+	prevFields := prev.Fields()
+	for _, nfd := range fields(next) {
+		nopts, nattr := fieldOptions(nfd), attrName(nfd)
+
+		pfd := prevFields.ByNumber(nfd.Number())
+		if pfd == nil || fieldOptions(pfd).GetOmit() {
+			if nopts.GetPk() || nopts.GetSk() {
+				report(KeyChanged, nfd, "new field is marked as %s", keyName(nopts))
+			}
+			if old, ok := prevByAttr[nattr]; ok && old.Number() != nfd.Number() {
+				report(AttributeReused, nfd, "attribute '%s' was used by field '%s'", nattr, old.Name())
+			}
+			continue
+		}
+
+		popts, pattr := fieldOptions(pfd), attrName(pfd)
+		if pattr != nattr {
+			report(AttributeRenamed, nfd, "attribute name changed from '%s' to '%s'", pattr, nattr)
+		}
+		if keyName(popts) != keyName(nopts) {
+			report(KeyChanged, nfd, "key changed from %s to %s", keyName(popts), keyName(nopts))
+		}
+		if pidx, nidx := indexKeys(popts), indexKeys(nopts); pidx != nidx {
+			report(KeyChanged, nfd, "index keys changed from %s to %s", pidx, nidx)
+		}
+		if encoding(popts) != encoding(nopts) {
+			report(EncodingChanged, nfd, "embed encoding changed from %s to %s", encoding(popts), encoding(nopts))
+		}
+		if popts.GetCodec() != nopts.GetCodec() {
+			report(EncodingChanged, nfd, "codec changed from '%s' to '%s'", popts.GetCodec(), nopts.GetCodec())
+		}
+		if popts.GetSet() != nopts.GetSet() {
+			report(SetChanged, nfd, "changed from %s to %s", listName(popts), listName(nopts))
+		}
+		if ptyp, ntyp := fieldType(pfd), fieldType(nfd); !readableAs(pfd, nfd) {
+			report(TypeChanged, nfd, "type changed from '%s' to '%s'", ptyp, ntyp)
+		}
+	}
+	return changes, nil
+}
+
+// validateOptions returns an error if the options of a field of message 'md' are malformed
+func validateOptions(md protoreflect.MessageDescriptor) error {
+	for i := 0; i < md.Fields().Len(); i++ {
+		if _, err := ddb.DynamicFieldOptions(md.Fields().Get(i)); err != nil {
+			return fmt.Errorf("failed to read options of field '%s': %w", md.Fields().Get(i).FullName(), err)
+		}
+	}
+	return nil
+}
+
+// fieldOptions returns the options of a field, of a message that passed validateOptions
+func fieldOptions(fd protoreflect.FieldDescriptor) *ddbv1.FieldOptions {
+	fopts, _ := ddb.DynamicFieldOptions(fd)
+	return fopts
+}
+
+// attrName returns the attribute name of a field, of a message that passed validateOptions
+func attrName(fd protoreflect.FieldDescriptor) string {
+	name, _ := ddb.DynamicAttrName(fd)
+	return name
+}
+
+// messages returns all (nested) messages in the files by their full name
+func messages(files *protoregistry.Files) map[protoreflect.FullName]protoreflect.MessageDescriptor {
+	msgs := map[protoreflect.FullName]protoreflect.MessageDescriptor{}
+
+	var walk func(mds protoreflect.MessageDescriptors)
+	walk = func(mds protoreflect.MessageDescriptors) {
+		for i := 0; i < mds.Len(); i++ {
+			if mds.Get(i).IsMapEntry() {
+				continue
+			}
+			msgs[mds.Get(i).FullName()] = mds.Get(i)
+			walk(mds.Get(i).Messages())
+		}
+	}
+
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		walk(fd.Messages())
+		return true
+	})
+	return msgs
+}
+
+// fields returns the fields of a message that are not omitted
+func fields(md protoreflect.MessageDescriptor) (fds []protoreflect.FieldDescriptor) {
+	for i := 0; i < md.Fields().Len(); i++ {
+		if fd := md.Fields().Get(i); !fieldOptions(fd).GetOmit() {
+			fds = append(fds, fd)
+		}
+	}
+	return
+}
+
+// keyName describes the key role of a field
+func keyName(fopts *ddbv1.FieldOptions) string {
+	switch {
+	case fopts.GetPk():
+		return "partition key"
+	case fopts.GetSk():
+		return "sort key"
+	default:
+		return "no key"
+	}
+}
+
+// indexKeys describes the secondary index keys of a field, e.g: "gsi_pk=by_forks lsi_sk=by_knifes"
+func indexKeys(fopts *ddbv1.FieldOptions) string {
+	var keys []string
+	for _, idx := range []struct {
+		name  string
+		names []string
+	}{{"gsi_pk", fopts.GetGsiPk()}, {"gsi_sk", fopts.GetGsiSk()}, {"lsi_sk", fopts.GetLsiSk()}} {
+		for _, name := range idx.names {
+			keys = append(keys, idx.name+"="+name)
+		}
+	}
+	if len(keys) == 0 {
+		return "no index keys"
+	}
+
+	sort.Strings(keys)
+	return strings.Join(keys, " ")
+}
+
+// listName describes whether a field is stored as a list or a set
+func listName(fopts *ddbv1.FieldOptions) string {
+	if fopts.GetSet() {
+		return "set"
+	}
+	return "list"
+}
+
+// encoding normalizes the embed encoding, unspecified is the same as dynamo
+func encoding(fopts *ddbv1.FieldOptions) ddbv1.Encoding {
+	if fopts.GetEmbed() == ddbv1.Encoding_ENCODING_JSON {
+		return ddbv1.Encoding_ENCODING_JSON
+	}
+	return ddbv1.Encoding_ENCODING_DYNAMO
+}
+
+// fieldType describes the type of the field for reporting
+func fieldType(fd protoreflect.FieldDescriptor) string {
+	switch {
+	case fd.IsMap():
+		return "map<" + fieldType(fd.MapKey()) + "," + fieldType(fd.MapValue()) + ">"
+	case fd.IsList():
+		return "repeated " + kindType(fd)
+	default:
+		return kindType(fd)
+	}
+}
+
+// kindType describes the type of a single (non-repeated) value
+func kindType(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(fd.Message().FullName())
+	case protoreflect.EnumKind:
+		return string(fd.Enum().FullName())
+	default:
+		return fd.Kind().String()
+	}
+}
+
+// readableAs returns whether attributes written for field 'prev' can be read by field 'next' without
+// losing values. Numbers can be read into any kind that holds all values of the previous kind.
+func readableAs(prev, next protoreflect.FieldDescriptor) bool {
+	switch {
+	case prev.IsMap() != next.IsMap() || prev.IsList() != next.IsList():
+		return false
+	case prev.IsMap():
+		return readableAs(prev.MapKey(), next.MapKey()) && readableAs(prev.MapValue(), next.MapValue())
+	}
+
+	pnum, pok := numberKinds[prev.Kind()]
+	nnum, nok := numberKinds[next.Kind()]
+	if pok && nok {
+		return nnum.holds(pnum)
+	}
+	return kindType(prev) == kindType(next)
+}
+
+// numberKind describes the values of a numeric kind, that are all stored as a number attribute
+type numberKind struct {
+	float  bool
+	signed bool
+	bits   int // bits of the value (without sign), or of the mantissa for floats
+}
+
+// numberKinds maps numeric kinds to the values they hold, enums hold the values of an int32
+var numberKinds = map[protoreflect.Kind]numberKind{
+	protoreflect.EnumKind:     {signed: true, bits: 31},
+	protoreflect.Int32Kind:    {signed: true, bits: 31},
+	protoreflect.Sint32Kind:   {signed: true, bits: 31},
+	protoreflect.Sfixed32Kind: {signed: true, bits: 31},
+	protoreflect.Int64Kind:    {signed: true, bits: 63},
+	protoreflect.Sint64Kind:   {signed: true, bits: 63},
+	protoreflect.Sfixed64Kind: {signed: true, bits: 63},
+	protoreflect.Uint32Kind:   {bits: 32},
+	protoreflect.Fixed32Kind:  {bits: 32},
+	protoreflect.Uint64Kind:   {bits: 64},
+	protoreflect.Fixed64Kind:  {bits: 64},
+	protoreflect.FloatKind:    {float: true, signed: true, bits: 24},
+	protoreflect.DoubleKind:   {float: true, signed: true, bits: 53},
+}
+
+// holds returns whether every value of kind 'o' can be read as kind 'k'
+func (k numberKind) holds(o numberKind) bool {
+	switch {
+	case o.float:
+		return k.float && k.bits >= o.bits // fractions cannot be read into integers
+	case k.float:
+		return k.bits >= o.bits // integers beyond the mantissa are rounded
+	case o.signed && !k.signed:
+		return false // negative numbers cannot be read into unsigned integers
+	case !o.signed && k.signed:
+		return k.bits > o.bits
+	default:
+		return k.bits >= o.bits
+	}
+}
@@ -0,0 +1,197 @@
+package ddbcompat_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb/ddbcompat"
+	ddbv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+	messagev1 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestDdbcompat(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ddb/ddbcompat")
+}
+
+// files resolves a single file descriptor into a set of files
+func files(fdp *descriptorpb.FileDescriptorProto) *protoregistry.Files {
+	fs, err := (protodesc.FileOptions{AllowUnresolvable: true}).NewFiles(
+		&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{fdp}})
+	Expect(err).ToNot(HaveOccurred())
+	return fs
+}
+
+// field finds a field in a message of the file
+func field(fdp *descriptorpb.FileDescriptorProto, msg, name string) *descriptorpb.FieldDescriptorProto {
+	for _, m := range fdp.MessageType {
+		for _, f := range m.Field {
+			if m.GetName() == msg && f.GetName() == name {
+				return f
+			}
+		}
+	}
+	Fail("field not found: " + msg + "." + name)
+	return nil
+}
+
+// fieldOptions modifies the plugin specific options of a field
+func fieldOptions(f *descriptorpb.FieldDescriptorProto, mod func(o *ddbv1.FieldOptions)) {
+	if f.Options == nil {
+		f.Options = &descriptorpb.FieldOptions{}
+	}
+
+	fopts, _ := proto.GetExtension(f.Options, ddbv1.E_Field).(*ddbv1.FieldOptions)
+	if fopts == nil {
+		fopts = &ddbv1.FieldOptions{}
+	}
+	mod(fopts)
+	proto.SetExtension(f.Options, ddbv1.E_Field, fopts)
+}
+
+var _ = DescribeTable("compare", func(mod func(fdp *descriptorpb.FileDescriptorProto), exp []string) {
+	prev := protodesc.ToFileDescriptorProto(messagev1.File_example_message_v1_message_proto)
+	next := proto.Clone(prev).(*descriptorpb.FileDescriptorProto)
+	mod(next)
+
+	var act []string
+	changes, err := ddbcompat.Compare(files(prev), files(next))
+	Expect(err).ToNot(HaveOccurred())
+	for _, c := range changes {
+		act = append(act, c.String())
+	}
+	Expect(act).To(Equal(exp))
+},
+	Entry("no changes", func(fdp *descriptorpb.FileDescriptorProto) {}, nil),
+	Entry("field renamed", func(fdp *descriptorpb.FileDescriptorProto) {
+		field(fdp, "Kitchen", "num_small_knifes").Name = proto.String("num_tiny_knifes")
+	}, nil),
+	Entry("numbers widened", func(fdp *descriptorpb.FileDescriptorProto) {
+		field(fdp, "Kitchen", "num_small_knifes").Type = descriptorpb.FieldDescriptorProto_TYPE_SINT64.Enum()
+		field(fdp, "Kitchen", "num_sharp_knifes").Type = descriptorpb.FieldDescriptorProto_TYPE_DOUBLE.Enum()
+		field(fdp, "Kitchen", "num_blunt_knifes").Type = descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum()
+		field(fdp, "Kitchen", "num_medium_forks").Type = descriptorpb.FieldDescriptorProto_TYPE_UINT64.Enum()
+		field(fdp, "Kitchen", "percent_black_tiles").Type = descriptorpb.FieldDescriptorProto_TYPE_DOUBLE.Enum()
+	}, nil),
+	Entry("numbers narrowed", func(fdp *descriptorpb.FileDescriptorProto) {
+		field(fdp, "Kitchen", "num_small_forks").Type = descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum()
+		field(fdp, "Kitchen", "num_large_forks").Type = descriptorpb.FieldDescriptorProto_TYPE_DOUBLE.Enum()
+		field(fdp, "Kitchen", "percent_white_tiles").Type = descriptorpb.FieldDescriptorProto_TYPE_FLOAT.Enum()
+	}, []string{
+		"example.message.v1.Kitchen.num_large_forks: type changed: type changed from 'uint64' to 'double'",
+		"example.message.v1.Kitchen.num_small_forks: type changed: type changed from 'int64' to 'int32'",
+		"example.message.v1.Kitchen.percent_white_tiles: type changed: type changed from 'double' to 'float'",
+	}),
+	Entry("signed to unsigned", func(fdp *descriptorpb.FileDescriptorProto) {
+		field(fdp, "Kitchen", "num_small_knifes").Type = descriptorpb.FieldDescriptorProto_TYPE_UINT64.Enum()
+		field(fdp, "Kitchen", "num_blunt_knifes").Type = descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum()
+	}, []string{
+		"example.message.v1.Kitchen.num_blunt_knifes: type changed: type changed from 'uint32' to 'int32'",
+		"example.message.v1.Kitchen.num_small_knifes: type changed: type changed from 'int32' to 'uint64'",
+	}),
+	Entry("map value narrowed", func(fdp *descriptorpb.FileDescriptorProto) {
+		for _, m := range fdp.MessageType {
+			for _, nm := range m.NestedType {
+				if m.GetName() == "Kitchen" && nm.GetName() == "CalendarEntry" {
+					nm.Field[1].Type = descriptorpb.FieldDescriptorProto_TYPE_UINT32.Enum()
+				}
+			}
+		}
+	}, []string{"example.message.v1.Kitchen.calendar: type changed: type changed from 'map<string,int64>' to 'map<string,uint32>'"}),
+	Entry("non-key field omitted", func(fdp *descriptorpb.FileDescriptorProto) {
+		fieldOptions(field(fdp, "Kitchen", "is_renovated"), func(o *ddbv1.FieldOptions) { o.Omit = proto.Bool(true) })
+	}, nil),
+	Entry("attribute renamed", func(fdp *descriptorpb.FileDescriptorProto) {
+		fieldOptions(field(fdp, "Kitchen", "num_small_knifes"), func(o *ddbv1.FieldOptions) { o.Name = proto.String("knifes") })
+	}, []string{"example.message.v1.Kitchen.num_small_knifes: attribute renamed: attribute name changed from '4' to 'knifes'"}),
+	Entry("key changed", func(fdp *descriptorpb.FileDescriptorProto) {
+		fieldOptions(field(fdp, "Kitchen", "brand"), func(o *ddbv1.FieldOptions) { o.Pk = nil })
+		fieldOptions(field(fdp, "Kitchen", "is_renovated"), func(o *ddbv1.FieldOptions) { o.Pk = proto.Bool(true) })
+	}, []string{
+		"example.message.v1.Kitchen.brand: key changed: key changed from partition key to no key",
+		"example.message.v1.Kitchen.is_renovated: key changed: key changed from no key to partition key",
+	}),
+	Entry("index keys changed", func(fdp *descriptorpb.FileDescriptorProto) {
+		fieldOptions(field(fdp, "Kitchen", "num_small_knifes"), func(o *ddbv1.FieldOptions) { o.LsiSk = nil })
+		fieldOptions(field(fdp, "Kitchen", "num_small_forks"), func(o *ddbv1.FieldOptions) { o.GsiPk = []string{"by_forks", "by_cutlery"} })
+		fieldOptions(field(fdp, "Kitchen", "is_renovated"), func(o *ddbv1.FieldOptions) { o.GsiSk = []string{"by_forks"} })
+	}, []string{
+		"example.message.v1.Kitchen.is_renovated: key changed: index keys changed from no index keys to gsi_sk=by_forks",
+		"example.message.v1.Kitchen.num_small_forks: key changed: index keys changed from gsi_pk=by_forks to gsi_pk=by_cutlery gsi_pk=by_forks",
+		"example.message.v1.Kitchen.num_small_knifes: key changed: index keys changed from lsi_sk=by_knifes to no index keys",
+	}),
+	Entry("key field omitted", func(fdp *descriptorpb.FileDescriptorProto) {
+		fieldOptions(field(fdp, "Kitchen", "qr_code"), func(o *ddbv1.FieldOptions) { o.Omit = proto.Bool(true) })
+	}, []string{"example.message.v1.Kitchen.qr_code: key changed: sort key field was removed"}),
+	Entry("encoding changed", func(fdp *descriptorpb.FileDescriptorProto) {
+		fieldOptions(field(fdp, "Kitchen", "washer_engine"), func(o *ddbv1.FieldOptions) {
+			o.Embed = ddbv1.Encoding_ENCODING_JSON.Enum()
+		})
+	}, []string{"example.message.v1.Kitchen.washer_engine: encoding changed: embed encoding changed from ENCODING_DYNAMO to ENCODING_JSON"}),
+	Entry("codec changed", func(fdp *descriptorpb.FileDescriptorProto) {
+		fieldOptions(field(fdp, "Kitchen", "washer_engine"), func(o *ddbv1.FieldOptions) { o.Codec = proto.String("engine") })
+	}, []string{"example.message.v1.Kitchen.washer_engine: encoding changed: codec changed from '' to 'engine'"}),
+	Entry("list to set", func(fdp *descriptorpb.FileDescriptorProto) {
+		fieldOptions(field(fdp, "Kitchen", "other_brands"), func(o *ddbv1.FieldOptions) { o.Set = proto.Bool(true) })
+	}, []string{"example.message.v1.Kitchen.other_brands: set changed: changed from list to set"}),
+	Entry("type changed", func(fdp *descriptorpb.FileDescriptorProto) {
+		field(fdp, "Kitchen", "other_brands").Type = descriptorpb.FieldDescriptorProto_TYPE_BYTES.Enum()
+		field(fdp, "Kitchen", "percent_white_tiles").Type = descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum()
+	}, []string{
+		"example.message.v1.Kitchen.other_brands: type changed: type changed from 'repeated string' to 'repeated bytes'",
+		"example.message.v1.Kitchen.percent_white_tiles: type changed: type changed from 'double' to 'int32'",
+	}),
+	Entry("attribute reused", func(fdp *descriptorpb.FileDescriptorProto) {
+		f := field(fdp, "Kitchen", "is_renovated")
+		f.Number = proto.Int32(999)
+		f.Name = proto.String("renovation_date")
+		f.Type = descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()
+		f.JsonName = nil
+		fieldOptions(f, func(o *ddbv1.FieldOptions) { o.Name = proto.String("2") })
+	}, []string{"example.message.v1.Kitchen.renovation_date: attribute reused: attribute '2' was used by field 'is_renovated'"}),
+)
+
+var _ = Describe("malformed options", func() {
+	It("should error instead of comparing", func() {
+		prev := protodesc.ToFileDescriptorProto(messagev1.File_example_message_v1_message_proto)
+		next := proto.Clone(prev).(*descriptorpb.FileDescriptorProto)
+
+		f := field(next, "Engine", "brand")
+		f.Options = &descriptorpb.FieldOptions{}
+		f.Options.ProtoReflect().SetUnknown( // a name that is cut short
+			protowire.AppendBytes(protowire.AppendTag(nil, 1098, protowire.BytesType), []byte{0x0a, 0x05, 'a'}))
+
+		_, err := ddbcompat.Compare(files(prev), files(next))
+		Expect(err).To(MatchError(ContainSubstring("failed to read options of field 'example.message.v1.Engine.brand'")))
+	})
+})
+
+var _ = Describe("read files", func() {
+	It("should read a descriptor set from disk", func() {
+		fds := &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(messagev1.File_example_message_v1_message_proto),
+		}}
+		b, err := proto.Marshal(fds)
+		Expect(err).ToNot(HaveOccurred())
+
+		name := filepath.Join(GinkgoT().TempDir(), "image.binpb")
+		Expect(os.WriteFile(name, b, 0600)).To(Succeed())
+
+		fs, err := ddbcompat.ReadFiles(name)
+		Expect(err).ToNot(HaveOccurred())
+		changes, err := ddbcompat.Compare(fs, fs)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		_, err = fs.FindDescriptorByName("example.message.v1.Kitchen")
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,370 @@
+package ddbexport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// binaryVersionMarker starts every Ion 1.0 binary stream
+var binaryVersionMarker = []byte{0xE0, 0x01, 0x00, 0xEA}
+
+// systemSymbols is the Ion 1.0 system symbol table, symbol id 0 has unknown text
+var systemSymbols = []string{
+	"", "$ion", "$ion_1_0", "$ion_symbol_table", "name", "version",
+	"imports", "symbols", "max_id", "$ion_shared_symbol_table",
+}
+
+// byteReader is implemented by both the stream and the in-memory container readers
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// binaryDecoder decodes values from Ion binary
+type binaryDecoder struct {
+	r       *bufio.Reader
+	symbols []string
+}
+
+// newBinaryDecoder inits the binary decoder
+func newBinaryDecoder(r *bufio.Reader) *binaryDecoder {
+	d := &binaryDecoder{r: r}
+	d.resetSymbols()
+	return d
+}
+
+// resetSymbols resets the symbol table to only hold the system symbols
+func (d *binaryDecoder) resetSymbols() {
+	d.symbols = append([]string{}, systemSymbols...)
+}
+
+// next decodes the next top-level value, processing version markers and local symbol tables
+func (d *binaryDecoder) next() (types.AttributeValue, error) {
+	for {
+		if b, _ := d.r.Peek(len(binaryVersionMarker)); bytes.Equal(b, binaryVersionMarker) {
+			_, _ = d.r.Discard(len(b))
+			d.resetSymbols()
+			continue
+		}
+
+		if _, err := d.r.Peek(1); err != nil {
+			return nil, err
+		}
+
+		v, annots, err := d.value(d.r)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case v == nil:
+			continue // padding
+		case len(annots) > 0 && annots[0] == "$ion_symbol_table":
+			if err = d.symbolTable(v); err != nil {
+				return nil, fmt.Errorf("failed to process symbol table: %w", err)
+			}
+			continue
+		}
+		return v, nil
+	}
+}
+
+// symbolTable updates the symbols from a local symbol table struct
+func (d *binaryDecoder) symbolTable(v types.AttributeValue) error {
+	st, ok := v.(*types.AttributeValueMemberM)
+	if !ok {
+		return fmt.Errorf("expected struct, got: %T", v)
+	}
+
+	switch imports := st.Value["imports"].(type) {
+	case nil:
+		d.resetSymbols()
+	case *types.AttributeValueMemberS:
+		if imports.Value != "$ion_symbol_table" {
+			d.resetSymbols()
+		}
+	default:
+		return fmt.Errorf("shared symbol table imports are not supported")
+	}
+
+	syms, ok := st.Value["symbols"].(*types.AttributeValueMemberL)
+	if !ok {
+		return nil
+	}
+	for _, sym := range syms.Value {
+		s, _ := sym.(*types.AttributeValueMemberS)
+		if s == nil {
+			d.symbols = append(d.symbols, "") // non-string entries are symbols with unknown text
+			continue
+		}
+		d.symbols = append(d.symbols, s.Value)
+	}
+	return nil
+}
+
+// symbol resolves the text of a symbol id
+func (d *binaryDecoder) symbol(sid uint64) (string, error) {
+	if sid >= uint64(len(d.symbols)) {
+		return "", fmt.Errorf("symbol id '%d' is not in the symbol table", sid)
+	}
+	return d.symbols[sid], nil
+}
+
+// value decodes a single value and returns it together with its annotations. It returns a nil
+// value for padding.
+func (d *binaryDecoder) value(r byteReader) (types.AttributeValue, []string, error) {
+	td, err := r.ReadByte()
+	if err != nil {
+		return nil, nil, unexpected(err)
+	}
+
+	typ, l := td>>4, td&0x0F
+	if l == 0x0F {
+		if typ == 0x0E || typ == 0x0F {
+			return nil, nil, fmt.Errorf("invalid type descriptor: %#x", td)
+		}
+		return &types.AttributeValueMemberNULL{Value: true}, nil, nil
+	}
+
+	length := uint64(l)
+	switch {
+	case typ == 0x01:
+		length = 0 // the bool value is stored in the length nibble
+	case l == 0x0E, typ == 0x0D && l == 0x01:
+		if length, err = varUInt(r); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	body, err := readBody(r, length)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch typ {
+	case 0x0:
+		return nil, nil, nil
+	case 0xE:
+		return d.annotated(bytes.NewReader(body))
+	}
+
+	v, err := d.scalarOrContainer(typ, l, body)
+	return v, nil, err
+}
+
+// annotated decodes the contents of an annotation wrapper
+func (d *binaryDecoder) annotated(r *bytes.Reader) (types.AttributeValue, []string, error) {
+	alen, err := varUInt(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	abuf, err := readBody(r, alen)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var annots []string
+	for ar := bytes.NewReader(abuf); ar.Len() > 0; {
+		sid, err := varUInt(ar)
+		if err != nil {
+			return nil, nil, err
+		}
+		annot, err := d.symbol(sid)
+		if err != nil {
+			return nil, nil, err
+		}
+		annots = append(annots, annot)
+	}
+
+	v, _, err := d.value(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v, err = toSet(annots, v)
+	return v, annots, err
+}
+
+// scalarOrContainer decodes the body of all non-wrapper types
+func (d *binaryDecoder) scalarOrContainer(typ, l byte, body []byte) (types.AttributeValue, error) {
+	switch typ {
+	case 0x1:
+		return &types.AttributeValueMemberBOOL{Value: l == 1}, nil
+	case 0x2, 0x3:
+		i := new(big.Int).SetBytes(body)
+		if typ == 0x3 {
+			i.Neg(i)
+		}
+		n, err := intNumber(i)
+		return &types.AttributeValueMemberN{Value: n}, err
+	case 0x4:
+		var f float64
+		switch len(body) {
+		case 0:
+		case 4:
+			f = float64(math.Float32frombits(binary.BigEndian.Uint32(body)))
+		case 8:
+			f = math.Float64frombits(binary.BigEndian.Uint64(body))
+		default:
+			return nil, fmt.Errorf("invalid float length: %d", len(body))
+		}
+		n, err := floatNumber(f)
+		return &types.AttributeValueMemberN{Value: n}, err
+	case 0x5:
+		n, err := binaryDecimal(body)
+		return &types.AttributeValueMemberN{Value: n}, err
+	case 0x6:
+		return nil, fmt.Errorf("timestamps are not supported")
+	case 0x7:
+		sid := new(big.Int).SetBytes(body)
+		if !sid.IsUint64() {
+			return nil, fmt.Errorf("symbol id is too large")
+		}
+		s, err := d.symbol(sid.Uint64())
+		return &types.AttributeValueMemberS{Value: s}, err
+	case 0x8:
+		return &types.AttributeValueMemberS{Value: string(body)}, nil
+	case 0x9, 0xA:
+		return &types.AttributeValueMemberB{Value: body}, nil
+	case 0xB, 0xC:
+		return d.list(bytes.NewReader(body))
+	case 0xD:
+		return d.structure(bytes.NewReader(body))
+	default:
+		return nil, fmt.Errorf("invalid type code: %#x", typ)
+	}
+}
+
+// list decodes the elements of a list or s-expression
+func (d *binaryDecoder) list(r *bytes.Reader) (types.AttributeValue, error) {
+	l := &types.AttributeValueMemberL{Value: []types.AttributeValue{}}
+	for r.Len() > 0 {
+		v, _, err := d.value(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode list item '%d': %w", len(l.Value), err)
+		}
+		if v != nil {
+			l.Value = append(l.Value, v)
+		}
+	}
+	return l, nil
+}
+
+// structure decodes the fields of a struct
+func (d *binaryDecoder) structure(r *bytes.Reader) (types.AttributeValue, error) {
+	m := &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}}
+	for r.Len() > 0 {
+		sid, err := varUInt(r)
+		if err != nil {
+			return nil, err
+		}
+		name, err := d.symbol(sid)
+		if err != nil {
+			return nil, err
+		}
+
+		v, _, err := d.value(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode field '%s': %w", name, err)
+		}
+		if v != nil {
+			m.Value[name] = v
+		}
+	}
+	return m, nil
+}
+
+// readBody reads the 'length' bytes of a value. The length is read from the input, so it is not
+// trusted to allocate up front: the bytes are copied as they are read and a value that is longer
+// than the rest of the input is reported as truncated.
+func readBody(r io.Reader, length uint64) ([]byte, error) {
+	if br, ok := r.(interface{ Len() int }); ok && length > uint64(br.Len()) {
+		return nil, fmt.Errorf("value of %d bytes is truncated: %w", length, io.ErrUnexpectedEOF)
+	}
+	if length > math.MaxInt64 {
+		return nil, fmt.Errorf("value of %d bytes is too large", length)
+	}
+
+	var buf bytes.Buffer
+	if n, err := io.CopyN(&buf, r, int64(length)); err != nil {
+		return nil, fmt.Errorf("value of %d bytes is truncated after %d bytes: %w", length, n, unexpected(err))
+	}
+	return buf.Bytes(), nil
+}
+
+// binaryDecimal decodes a VarInt exponent followed by an Int coefficient
+func binaryDecimal(body []byte) (string, error) {
+	if len(body) == 0 {
+		return "0", nil
+	}
+
+	r := bytes.NewReader(body)
+	exp, err := varInt(r)
+	if err != nil {
+		return "", err
+	}
+
+	coef := make([]byte, r.Len())
+	_, _ = r.Read(coef)
+
+	var neg bool
+	if len(coef) > 0 {
+		neg = coef[0]&0x80 != 0
+		coef[0] &= 0x7F
+	}
+
+	c := new(big.Int).SetBytes(coef)
+	if neg {
+		c.Neg(c)
+	}
+	return decimalNumber(neg, c, int(exp))
+}
+
+// varUInt decodes an unsigned integer of 7 bits per byte, the last byte has its high bit set
+func varUInt(r io.ByteReader) (v uint64, err error) {
+	for i := 0; i < 10; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, unexpected(err)
+		}
+
+		v = v<<7 | uint64(b&0x7F)
+		if b&0x80 != 0 {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("var uint is too large")
+}
+
+// varInt decodes a signed integer like varUInt, with the sign in the second bit of the first byte
+func varInt(r io.ByteReader) (int64, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, unexpected(err)
+	}
+
+	neg := b&0x40 != 0
+	v := int64(b & 0x3F)
+	for i := 0; b&0x80 == 0; i++ {
+		if i >= 9 {
+			return 0, fmt.Errorf("var int is too large")
+		}
+		if b, err = r.ReadByte(); err != nil {
+			return 0, unexpected(err)
+		}
+		v = v<<7 | int64(b&0x7F)
+	}
+
+	if neg {
+		v = -v
+	}
+	return v, nil
+}
@@ -0,0 +1,98 @@
+package ddbexport
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// maxPrecision is the maximum number of significant digits DynamoDB supports for numbers
+const maxPrecision = 38
+
+// DynamoDB numbers range from 1E-130 to 9.9999999999999999999999999999999999999E+125, these are the
+// smallest and largest exponent of a number in scientific notation.
+const (
+	minExponent = -130
+	maxExponent = 125
+)
+
+// decimalNumber formats an Ion decimal (coefficient * 10^exponent) as a DynamoDB number without
+// losing precision. It returns an error if the number has more significant digits than DynamoDB
+// supports.
+func decimalNumber(neg bool, coef *big.Int, exp int) (string, error) {
+	digits := new(big.Int).Abs(coef).String()
+	if digits == "0" {
+		return "0", nil
+	}
+
+	// reject wild exponents before any arithmetic on them can overflow
+	if exp < math.MinInt32 || exp > math.MaxInt32 {
+		return "", errNumberRange(digits, exp)
+	}
+
+	// trailing zeros are not significant, move them into the exponent
+	trimmed := strings.TrimRight(digits, "0")
+	exp += len(digits) - len(trimmed)
+	digits = trimmed
+	if len(digits) > maxPrecision {
+		return "", fmt.Errorf("number has %d significant digits, DynamoDB supports at most %d", len(digits), maxPrecision)
+	}
+
+	// check the range before the exponent is expanded into zeros
+	if sci := len(digits) - 1 + exp; sci < minExponent || sci > maxExponent {
+		return "", errNumberRange(digits, exp)
+	}
+
+	var s string
+	switch {
+	case exp >= 0:
+		s = digits + strings.Repeat("0", exp)
+	case -exp < len(digits):
+		s = digits[:len(digits)+exp] + "." + digits[len(digits)+exp:]
+	default:
+		s = "0." + strings.Repeat("0", -exp-len(digits)) + digits
+	}
+
+	if neg || coef.Sign() < 0 {
+		s = "-" + s
+	}
+	return s, nil
+}
+
+// errNumberRange is returned for numbers that are too large or too small for DynamoDB
+func errNumberRange(digits string, exp int) error {
+	return fmt.Errorf("number %sE%d is out of the range DynamoDB supports (1E%d to 9.99E%d)",
+		digits, exp, minExponent, maxExponent)
+}
+
+// intNumber formats an Ion integer as a DynamoDB number
+func intNumber(i *big.Int) (string, error) {
+	return decimalNumber(i.Sign() < 0, i, 0)
+}
+
+// floatNumber formats an Ion float as a DynamoDB number. DynamoDB has no representation for
+// NaN or infinity.
+func floatNumber(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("number '%v' is not supported by DynamoDB", f)
+	}
+
+	// format with the shortest representation and let the decimal logic normalize it
+	s := strconv.FormatFloat(f, 'e', -1, 64)
+	mant, exps, _ := strings.Cut(s, "e")
+	exp, err := strconv.Atoi(exps)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse float exponent: %w", err)
+	}
+
+	neg := strings.HasPrefix(mant, "-")
+	mant = strings.TrimPrefix(mant, "-")
+	if whole, frac, ok := strings.Cut(mant, "."); ok {
+		mant, exp = whole+frac, exp-len(frac)
+	}
+
+	coef, _ := new(big.Int).SetString(mant, 10)
+	return decimalNumber(neg, coef, exp)
+}
@@ -0,0 +1,127 @@
+// Package ddbexport reads the Amazon Ion files produced by DynamoDB's native export to S3
+package ddbexport
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// ItemUnmarshaler is implemented by the generated messages
+type ItemUnmarshaler interface {
+	UnmarshalDynamoItem(m map[string]types.AttributeValue) error
+}
+
+// decoder is implemented by the text and the binary Ion decoders
+type decoder interface {
+	next() (types.AttributeValue, error)
+}
+
+// Reader reads items from an Ion export file. Each top-level value in the file is expected to be a
+// struct with an 'Item' field, as written by DynamoDB: $ion_1_0 {Item:{...}}
+type Reader struct {
+	dec decoder
+}
+
+// NewReader inits a reader for the (uncompressed) export data in 'r'. It detects whether the
+// data is encoded as Ion text or as Ion binary.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(binaryVersionMarker))
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to peek export data: %w", err)
+	}
+
+	if bytes.Equal(magic, binaryVersionMarker) {
+		return &Reader{dec: newBinaryDecoder(br)}, nil
+	}
+	return &Reader{dec: newTextDecoder(br)}, nil
+}
+
+// Next reads the next item from the export. It returns io.EOF when there are no more items.
+func (r *Reader) Next() (map[string]types.AttributeValue, error) {
+	v, err := r.dec.next()
+	if err != nil {
+		return nil, err
+	}
+
+	top, ok := v.(*types.AttributeValueMemberM)
+	if !ok {
+		return nil, fmt.Errorf("expected top-level struct, got: %T", v)
+	}
+
+	item, ok := top.Value["Item"].(*types.AttributeValueMemberM)
+	if !ok {
+		return nil, fmt.Errorf("expected top-level struct to have an 'Item' struct field")
+	}
+	return item.Value, nil
+}
+
+// Decode reads the next item from the export and unmarshals it into 'x'. It returns io.EOF when
+// there are no more items.
+func (r *Reader) Decode(x ItemUnmarshaler) error {
+	item, err := r.Next()
+	if err != nil {
+		return err
+	}
+
+	if err = x.UnmarshalDynamoItem(item); err != nil {
+		return fmt.Errorf("failed to unmarshal item: %w", err)
+	}
+	return nil
+}
+
+// toSet turns a list into one of the set types if it is annotated as such by the export
+func toSet(annots []string, av types.AttributeValue) (types.AttributeValue, error) {
+	var typ string
+	for _, a := range annots {
+		switch a {
+		case "$dynamodb_SS", "$dynamodb_NS", "$dynamodb_BS":
+			typ = a
+		}
+	}
+	if typ == "" {
+		return av, nil
+	}
+
+	l, ok := av.(*types.AttributeValueMemberL)
+	if !ok {
+		return nil, fmt.Errorf("expected list for '%s' annotation, got: %T", typ, av)
+	}
+
+	switch typ {
+	case "$dynamodb_SS":
+		ss := &types.AttributeValueMemberSS{Value: make([]string, 0, len(l.Value))}
+		for _, v := range l.Value {
+			sv, ok := v.(*types.AttributeValueMemberS)
+			if !ok {
+				return nil, fmt.Errorf("expected string in string set, got: %T", v)
+			}
+			ss.Value = append(ss.Value, sv.Value)
+		}
+		return ss, nil
+	case "$dynamodb_NS":
+		ns := &types.AttributeValueMemberNS{Value: make([]string, 0, len(l.Value))}
+		for _, v := range l.Value {
+			nv, ok := v.(*types.AttributeValueMemberN)
+			if !ok {
+				return nil, fmt.Errorf("expected number in number set, got: %T", v)
+			}
+			ns.Value = append(ns.Value, nv.Value)
+		}
+		return ns, nil
+	default:
+		bs := &types.AttributeValueMemberBS{Value: make([][]byte, 0, len(l.Value))}
+		for _, v := range l.Value {
+			bv, ok := v.(*types.AttributeValueMemberB)
+			if !ok {
+				return nil, fmt.Errorf("expected blob in binary set, got: %T", v)
+			}
+			bs.Value = append(bs.Value, bv.Value)
+		}
+		return bs, nil
+	}
+}
@@ -0,0 +1,134 @@
+package ddbexport_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb/ddbexport"
+	messagev1 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDdbexport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ddb/ddbexport")
+}
+
+var _ = DescribeTable("export fixtures", func(file string) {
+	f, err := os.Open(file)
+	Expect(err).ToNot(HaveOccurred())
+	defer f.Close()
+
+	rd, err := ddbexport.NewReader(f)
+	Expect(err).ToNot(HaveOccurred())
+
+	var k1 messagev1.Kitchen
+	Expect(rd.Decode(&k1)).To(Succeed())
+	Expect(proto.Equal(&k1, &messagev1.Kitchen{
+		Brand:             "Siemens",
+		IsRenovated:       true,
+		QrCode:            []byte{0x01},
+		NumSmallKnifes:    4,
+		PercentWhiteTiles: 0.25,
+		Calendar:          map[string]int64{"foo": 42, "bar": -7},
+		OtherBrands:       []string{"a", "b"},
+		StringSet:         []string{"a", "b"},
+		NumberSet:         []int64{1, 2},
+		BytesSet:          [][]byte{{0x01}},
+	})).To(BeTrue())
+
+	var k2 messagev1.Kitchen
+	Expect(rd.Decode(&k2)).To(Succeed())
+	Expect(proto.Equal(&k2, &messagev1.Kitchen{
+		Brand:         "Bosch",
+		NumLargeForks: 18446744073709551615,
+		Dirtyness:     messagev1.Dirtyness_DIRTYNESS_CLEAN,
+		WasherEngine:  &messagev1.Engine{Brand: "diesel"},
+	})).To(BeTrue())
+
+	Expect(rd.Decode(&k2)).To(Equal(io.EOF))
+},
+	Entry("text", "testdata/export.ion"),
+	Entry("binary", "testdata/export.10n"),
+)
+
+var _ = DescribeTable("text values", func(in string, exp types.AttributeValue) {
+	rd, err := ddbexport.NewReader(strings.NewReader(`{Item:{a:` + in + `}}`))
+	Expect(err).ToNot(HaveOccurred())
+	item, err := rd.Next()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(item["a"]).To(Equal(exp))
+},
+	Entry("int", `1_000`, &types.AttributeValueMemberN{Value: "1000"}),
+	Entry("hex int", `-0x1F`, &types.AttributeValueMemberN{Value: "-31"}),
+	Entry("decimal", `1.50`, &types.AttributeValueMemberN{Value: "1.5"}),
+	Entry("decimal exponent", `15d-4`, &types.AttributeValueMemberN{Value: "0.0015"}),
+	Entry("decimal positive exponent", `-1.2d3`, &types.AttributeValueMemberN{Value: "-1200"}),
+	Entry("decimal trailing dot", `7.`, &types.AttributeValueMemberN{Value: "7"}),
+	Entry("negative zero", `-0.0`, &types.AttributeValueMemberN{Value: "0"}),
+	Entry("max precision", `1.2345678901234567890123456789012345678`,
+		&types.AttributeValueMemberN{Value: "1.2345678901234567890123456789012345678"}),
+	Entry("float", `2.5e-3`, &types.AttributeValueMemberN{Value: "0.0025"}),
+	Entry("string escapes", `"a\né"`, &types.AttributeValueMemberS{Value: "a\né"}),
+	Entry("long string", `'''foo''' '''bar'''`, &types.AttributeValueMemberS{Value: "foobar"}),
+	Entry("symbol", `'foo bar'`, &types.AttributeValueMemberS{Value: "foo bar"}),
+	Entry("blob", `{{ AQ == }}`, &types.AttributeValueMemberB{Value: []byte{0x01}}),
+	Entry("clob", `{{ "foo" }}`, &types.AttributeValueMemberB{Value: []byte("foo")}),
+	Entry("null", `null`, &types.AttributeValueMemberNULL{Value: true}),
+	Entry("typed null", `null.string`, &types.AttributeValueMemberNULL{Value: true}),
+	Entry("bool", `false`, &types.AttributeValueMemberBOOL{Value: false}),
+	Entry("list with comment", "[1, /* two */ 2 // end\n]", &types.AttributeValueMemberL{Value: []types.AttributeValue{
+		&types.AttributeValueMemberN{Value: "1"},
+		&types.AttributeValueMemberN{Value: "2"},
+	}}),
+	Entry("empty string set", `$dynamodb_SS::[]`, &types.AttributeValueMemberSS{Value: []string{}}),
+)
+
+var _ = DescribeTable("text errors", func(in string, expErr string) {
+	rd, err := ddbexport.NewReader(strings.NewReader(in))
+	Expect(err).ToNot(HaveOccurred())
+	_, err = rd.Next()
+	Expect(err).To(MatchError(MatchRegexp(expErr)))
+},
+	Entry("precision", `{Item:{a:1.23456789012345678901234567890123456789}}`, `39 significant digits`),
+	Entry("nan", `{Item:{a:nan}}`, `not supported by DynamoDB`),
+	Entry("infinity", `{Item:{a:+inf}}`, `not supported by DynamoDB`),
+	Entry("timestamp", `{Item:{a:2007-02-23}}`, `timestamps are not supported`),
+	Entry("no item", `{Foo:{}}`, `'Item' struct field`),
+	Entry("not a struct", `[]`, `expected top-level struct`),
+	Entry("unterminated", `{Item:{a:"foo`, `unexpected EOF`),
+	Entry("set of wrong type", `{Item:{a:$dynamodb_NS::["a"]}}`, `expected number in number set`),
+	Entry("exponent too large", `{Item:{a:1d999999999999}}`, `out of the range DynamoDB supports`),
+	Entry("exponent too small", `{Item:{a:1d-999999999999}}`, `out of the range DynamoDB supports`),
+	Entry("number too large", `{Item:{a:1d126}}`, `out of the range DynamoDB supports`),
+	Entry("number too small", `{Item:{a:1d-131}}`, `out of the range DynamoDB supports`),
+)
+
+var _ = DescribeTable("binary errors", func(in []byte, expErr string) {
+	rd, err := ddbexport.NewReader(bytes.NewReader(in))
+	Expect(err).ToNot(HaveOccurred())
+	_, err = rd.Next()
+	Expect(err).To(MatchError(MatchRegexp(expErr)))
+},
+	Entry("length beyond the input", append([]byte{0xE0, 0x01, 0x00, 0xEA, 0x8E},
+		0x7F, 0x7F, 0x7F, 0x7F, 0x7F, 0x7F, 0x7F, 0x7F, 0xFF), `is truncated`),
+	Entry("truncated string", []byte{0xE0, 0x01, 0x00, 0xEA, 0x85, 'a', 'b'}, `value of 5 bytes is truncated`),
+	Entry("truncated annotations", []byte{0xE0, 0x01, 0x00, 0xEA, 0xE3, 0x85, 0x81, 0x81}, `is truncated`),
+)
+
+var _ = Describe("number range", func() {
+	It("should accept the limits of DynamoDB's range", func() {
+		for _, in := range []string{`1d125`, `9.9999999999999999999999999999999999999d125`, `1d-130`, `-1d-130`} {
+			rd, err := ddbexport.NewReader(strings.NewReader(`{Item:{a:` + in + `}}`))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = rd.Next()
+			Expect(err).ToNot(HaveOccurred(), in)
+		}
+	})
+})
@@ -0,0 +1,591 @@
+package ddbexport
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// textDecoder decodes values from Ion text
+type textDecoder struct {
+	r *bufio.Reader
+}
+
+// newTextDecoder inits the text decoder
+func newTextDecoder(r *bufio.Reader) *textDecoder {
+	return &textDecoder{r: r}
+}
+
+// next decodes the next top-level value, skipping version markers and symbol tables
+func (d *textDecoder) next() (types.AttributeValue, error) {
+	for {
+		c, err := d.skipSpace()
+		if err != nil {
+			return nil, err
+		}
+
+		// the version marker is an unannotated top-level symbol
+		if c == '$' {
+			tok, err := d.peekToken()
+			if err != nil {
+				return nil, err
+			}
+			if tok == "$ion_1_0" {
+				_, _ = d.r.Discard(len(tok))
+				continue
+			}
+		}
+
+		v, annots, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(annots) > 0 && annots[0] == "$ion_symbol_table" {
+			continue // symbols are always inline in text, so the table can be ignored
+		}
+		return v, nil
+	}
+}
+
+// skipSpace skips whitespace and comments, and returns the next byte without consuming it
+func (d *textDecoder) skipSpace() (byte, error) {
+	for {
+		c, err := d.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+
+		switch c {
+		case ' ', '\t', '\n', '\r', '\f', '\v':
+			continue
+		case '/':
+			nc, err := d.r.ReadByte()
+			if err != nil {
+				return 0, unexpected(err)
+			}
+
+			switch nc {
+			case '/':
+				if _, err = d.r.ReadString('\n'); err != nil && err != io.EOF {
+					return 0, err
+				}
+			case '*':
+				if err = d.skipBlockComment(); err != nil {
+					return 0, err
+				}
+			default:
+				return 0, fmt.Errorf("unexpected character after '/': %q", nc)
+			}
+			continue
+		}
+
+		return c, d.r.UnreadByte()
+	}
+}
+
+// skipBlockComment skips until the end of a /* */ comment
+func (d *textDecoder) skipBlockComment() error {
+	var prev byte
+	for {
+		c, err := d.r.ReadByte()
+		if err != nil {
+			return unexpected(err)
+		}
+		if prev == '*' && c == '/' {
+			return nil
+		}
+		prev = c
+	}
+}
+
+// expect consumes the next non-space byte and checks that it is 'exp'
+func (d *textDecoder) expect(exp byte) error {
+	c, err := d.skipSpace()
+	if err != nil {
+		return unexpected(err)
+	}
+	if c != exp {
+		return fmt.Errorf("expected %q, got: %q", exp, c)
+	}
+	_, _ = d.r.ReadByte()
+	return nil
+}
+
+// peekToken returns the identifier or number token at the current position without consuming it
+func (d *textDecoder) peekToken() (string, error) {
+	for n := 1; ; n++ {
+		b, err := d.r.Peek(n)
+		if err == io.EOF {
+			return string(b), nil // all bytes until the end are part of the token
+		} else if err != nil {
+			return "", err
+		}
+
+		if !isTokenByte(b[n-1]) {
+			return string(b[:n-1]), nil
+		}
+	}
+}
+
+// readToken consumes the identifier or number token at the current position
+func (d *textDecoder) readToken() (string, error) {
+	tok, err := d.peekToken()
+	if err != nil {
+		return "", err
+	}
+	_, _ = d.r.Discard(len(tok))
+	return tok, nil
+}
+
+// annotations reads any 'sym::' annotations in front of a value. If it encounters a symbol that is
+// not followed by '::' it is the value itself, and it is returned as 'sym'.
+func (d *textDecoder) annotations() (annots []string, sym *string, err error) {
+	for {
+		c, err := d.skipSpace()
+		if err != nil {
+			return nil, nil, unexpected(err)
+		}
+
+		var s string
+		switch {
+		case c == '\'':
+			if b, _ := d.r.Peek(3); string(b) == "'''" {
+				return annots, nil, nil // long string, not a symbol
+			}
+			_, _ = d.r.ReadByte()
+			if s, err = d.quoted('\''); err != nil {
+				return nil, nil, err
+			}
+		case isIdentStart(c):
+			tok, err := d.peekToken()
+			if err != nil {
+				return nil, nil, err
+			}
+			if !isIdentifier(tok) {
+				return annots, nil, nil
+			}
+			_, _ = d.r.Discard(len(tok))
+			s = tok
+		default:
+			return annots, nil, nil
+		}
+
+		if _, err = d.skipSpace(); err != nil && err != io.EOF {
+			return nil, nil, err
+		}
+		if b, _ := d.r.Peek(2); string(b) != "::" {
+			return annots, &s, nil
+		}
+		_, _ = d.r.Discard(2)
+		annots = append(annots, s)
+	}
+}
+
+// value decodes a single value and returns it together with its annotations
+func (d *textDecoder) value() (types.AttributeValue, []string, error) {
+	annots, sym, err := d.annotations()
+	if err != nil {
+		return nil, nil, err
+	}
+	if sym != nil {
+		return &types.AttributeValueMemberS{Value: *sym}, annots, nil // symbols decode as strings
+	}
+
+	c, err := d.skipSpace()
+	if err != nil {
+		return nil, nil, unexpected(err)
+	}
+
+	var av types.AttributeValue
+	switch {
+	case c == '{':
+		if b, _ := d.r.Peek(2); string(b) == "{{" {
+			_, _ = d.r.Discard(2)
+			av, err = d.lob()
+		} else {
+			_, _ = d.r.ReadByte()
+			av, err = d.structure()
+		}
+	case c == '[':
+		_, _ = d.r.ReadByte()
+		av, err = d.list()
+	case c == '"':
+		_, _ = d.r.ReadByte()
+		var s string
+		s, err = d.quoted('"')
+		av = &types.AttributeValueMemberS{Value: s}
+	case c == '\'':
+		av, err = d.longString()
+	case c == '(':
+		return nil, nil, fmt.Errorf("s-expressions are not supported")
+	default:
+		var tok string
+		if tok, err = d.readToken(); err != nil {
+			return nil, nil, err
+		}
+		av, err = scalar(tok)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	av, err = toSet(annots, av)
+	return av, annots, err
+}
+
+// structure decodes the fields of a struct until the closing brace
+func (d *textDecoder) structure() (types.AttributeValue, error) {
+	m := &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}}
+	for {
+		c, err := d.skipSpace()
+		if err != nil {
+			return nil, unexpected(err)
+		}
+		if c == '}' {
+			_, _ = d.r.ReadByte()
+			return m, nil
+		}
+
+		name, err := d.fieldName()
+		if err != nil {
+			return nil, err
+		}
+		if err = d.expect(':'); err != nil {
+			return nil, err
+		}
+
+		if m.Value[name], _, err = d.value(); err != nil {
+			return nil, fmt.Errorf("failed to decode field '%s': %w", name, err)
+		}
+
+		if err = d.separator('}'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// list decodes the elements of a list until the closing bracket
+func (d *textDecoder) list() (types.AttributeValue, error) {
+	l := &types.AttributeValueMemberL{Value: []types.AttributeValue{}}
+	for {
+		c, err := d.skipSpace()
+		if err != nil {
+			return nil, unexpected(err)
+		}
+		if c == ']' {
+			_, _ = d.r.ReadByte()
+			return l, nil
+		}
+
+		v, _, err := d.value()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode list item '%d': %w", len(l.Value), err)
+		}
+		l.Value = append(l.Value, v)
+
+		if err = d.separator(']'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// separator consumes the comma between container elements, or leaves the closing byte in place
+func (d *textDecoder) separator(end byte) error {
+	c, err := d.skipSpace()
+	if err != nil {
+		return unexpected(err)
+	}
+
+	switch c {
+	case ',':
+		_, _ = d.r.ReadByte()
+		return nil
+	case end:
+		return nil
+	default:
+		return fmt.Errorf("expected ',' or %q, got: %q", end, c)
+	}
+}
+
+// fieldName decodes a struct field name, which can be a string or a symbol
+func (d *textDecoder) fieldName() (string, error) {
+	c, err := d.skipSpace()
+	if err != nil {
+		return "", unexpected(err)
+	}
+
+	switch {
+	case c == '"':
+		_, _ = d.r.ReadByte()
+		return d.quoted('"')
+	case c == '\'':
+		if b, _ := d.r.Peek(3); string(b) == "'''" {
+			av, err := d.longString()
+			if err != nil {
+				return "", err
+			}
+			return av.(*types.AttributeValueMemberS).Value, nil
+		}
+		_, _ = d.r.ReadByte()
+		return d.quoted('\'')
+	case isIdentStart(c):
+		return d.readToken()
+	default:
+		return "", fmt.Errorf("expected field name, got: %q", c)
+	}
+}
+
+// longString decodes one or more concatenated triple-quoted long strings
+func (d *textDecoder) longString() (types.AttributeValue, error) {
+	var sb strings.Builder
+	for {
+		if _, err := d.skipSpace(); err != nil && err != io.EOF {
+			return nil, err
+		}
+		if b, _ := d.r.Peek(3); string(b) != "'''" {
+			return &types.AttributeValueMemberS{Value: sb.String()}, nil
+		}
+		_, _ = d.r.Discard(3)
+
+		for {
+			if b, _ := d.r.Peek(3); string(b) == "'''" {
+				_, _ = d.r.Discard(3)
+				break
+			}
+			if err := d.char(&sb); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// quoted decodes a string or symbol up to the closing quote 'q'
+func (d *textDecoder) quoted(q byte) (string, error) {
+	var sb strings.Builder
+	for {
+		if b, err := d.r.Peek(1); err != nil {
+			return "", unexpected(err)
+		} else if b[0] == q {
+			_, _ = d.r.ReadByte()
+			return sb.String(), nil
+		}
+		if err := d.char(&sb); err != nil {
+			return "", err
+		}
+	}
+}
+
+// char decodes a single (possibly escaped) character into 'sb'
+func (d *textDecoder) char(sb *strings.Builder) error {
+	c, err := d.r.ReadByte()
+	if err != nil {
+		return unexpected(err)
+	}
+	if c != '\\' {
+		return sb.WriteByte(c)
+	}
+
+	if c, err = d.r.ReadByte(); err != nil {
+		return unexpected(err)
+	}
+
+	var hexlen int
+	switch c {
+	case 'a':
+		sb.WriteByte('\a')
+	case 'b':
+		sb.WriteByte('\b')
+	case 't':
+		sb.WriteByte('\t')
+	case 'n':
+		sb.WriteByte('\n')
+	case 'f':
+		sb.WriteByte('\f')
+	case 'r':
+		sb.WriteByte('\r')
+	case 'v':
+		sb.WriteByte('\v')
+	case '0':
+		sb.WriteByte(0)
+	case '?', '/', '\'', '"', '\\':
+		sb.WriteByte(c)
+	case '\n':
+		// escaped newline is a line continuation
+	case 'x':
+		hexlen = 2
+	case 'u':
+		hexlen = 4
+	case 'U':
+		hexlen = 8
+	default:
+		return fmt.Errorf("invalid escape sequence: \\%c", c)
+	}
+	if hexlen == 0 {
+		return nil
+	}
+
+	hex := make([]byte, hexlen)
+	if _, err = io.ReadFull(d.r, hex); err != nil {
+		return unexpected(err)
+	}
+	cp, err := strconv.ParseUint(string(hex), 16, 32)
+	if err != nil || !utf8.ValidRune(rune(cp)) {
+		return fmt.Errorf("invalid code point escape: \\%c%s", c, hex)
+	}
+	sb.WriteRune(rune(cp))
+	return nil
+}
+
+// lob decodes the contents of a {{ }} blob or clob, after the opening braces
+func (d *textDecoder) lob() (types.AttributeValue, error) {
+	c, err := d.skipSpace()
+	if err != nil {
+		return nil, unexpected(err)
+	}
+
+	var data []byte
+	if c == '"' || c == '\'' {
+		var sb strings.Builder
+		if c == '"' {
+			_, _ = d.r.ReadByte()
+			s, err := d.quoted('"')
+			if err != nil {
+				return nil, err
+			}
+			sb.WriteString(s)
+		} else {
+			av, err := d.longString()
+			if err != nil {
+				return nil, err
+			}
+			sb.WriteString(av.(*types.AttributeValueMemberS).Value)
+		}
+		data = []byte(sb.String())
+	} else {
+		enc, err := d.r.ReadString('}')
+		if err != nil {
+			return nil, unexpected(err)
+		}
+		enc = strings.Join(strings.Fields(enc[:len(enc)-1]), "")
+		if data, err = base64.StdEncoding.DecodeString(enc); err != nil {
+			return nil, fmt.Errorf("failed to decode blob: %w", err)
+		}
+		if err = d.r.UnreadByte(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = d.expect('}'); err != nil {
+		return nil, err
+	}
+	if c, err = d.r.ReadByte(); err != nil {
+		return nil, unexpected(err)
+	} else if c != '}' {
+		return nil, fmt.Errorf("expected '}}' to close blob, got: %q", c)
+	}
+	return &types.AttributeValueMemberB{Value: data}, nil
+}
+
+// scalar decodes keyword and numeric tokens
+func scalar(tok string) (types.AttributeValue, error) {
+	switch {
+	case tok == "":
+		return nil, fmt.Errorf("expected value")
+	case tok == "true" || tok == "false":
+		return &types.AttributeValueMemberBOOL{Value: tok == "true"}, nil
+	case tok == "null" || strings.HasPrefix(tok, "null."):
+		return &types.AttributeValueMemberNULL{Value: true}, nil
+	case tok == "nan" || tok == "+inf" || tok == "-inf":
+		return nil, fmt.Errorf("number '%s' is not supported by DynamoDB", tok)
+	}
+
+	n, err := number(tok)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AttributeValueMemberN{Value: n}, nil
+}
+
+// number decodes an Ion int, decimal or float token into a DynamoDB number
+func number(tok string) (string, error) {
+	lower := strings.ToLower(tok)
+	switch {
+	case strings.HasPrefix(strings.TrimPrefix(lower, "-"), "0x"),
+		strings.HasPrefix(strings.TrimPrefix(lower, "-"), "0b"):
+		i, ok := new(big.Int).SetString(lower, 0)
+		if !ok {
+			return "", fmt.Errorf("invalid integer: '%s'", tok)
+		}
+		return intNumber(i)
+	case strings.ContainsAny(lower, "t") || strings.LastIndex(lower, "-") > 0 &&
+		!strings.ContainsAny(lower, "de"):
+		return "", fmt.Errorf("timestamps are not supported, got: '%s'", tok)
+	case strings.Contains(lower, "e"):
+		f, err := strconv.ParseFloat(strings.ReplaceAll(lower, "_", ""), 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid float: '%s'", tok)
+		}
+		return floatNumber(f)
+	}
+
+	// ints and decimals are decoded as a coefficient and an exponent to keep full precision
+	mant, exps, _ := strings.Cut(strings.ReplaceAll(lower, "_", ""), "d")
+	exp := 0
+	if exps != "" {
+		var err error
+		if exp, err = strconv.Atoi(exps); err != nil {
+			return "", fmt.Errorf("invalid decimal exponent: '%s'", tok)
+		}
+	}
+
+	neg := strings.HasPrefix(mant, "-")
+	if whole, frac, ok := strings.Cut(mant, "."); ok {
+		mant, exp = whole+frac, exp-len(frac)
+	}
+
+	coef, ok := new(big.Int).SetString(strings.TrimPrefix(mant, "-"), 10)
+	if !ok {
+		return "", fmt.Errorf("invalid number: '%s'", tok)
+	}
+	return decimalNumber(neg, coef, exp)
+}
+
+// isTokenByte returns whether 'c' can be part of an identifier or numeric token
+func isTokenByte(c byte) bool {
+	return c == '_' || c == '$' || c == '.' || c == '+' || c == '-' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// isIdentStart returns whether 'c' can start an identifier
+func isIdentStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// isIdentifier returns whether 'tok' is an identifier symbol, rather than a keyword
+func isIdentifier(tok string) bool {
+	if tok == "" || !isIdentStart(tok[0]) || strings.ContainsAny(tok, ".+-") {
+		return false
+	}
+
+	switch tok {
+	case "null", "true", "false", "nan":
+		return false
+	}
+	return true
+}
+
+// unexpected turns an EOF in the middle of a value into an error
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
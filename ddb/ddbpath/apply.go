@@ -0,0 +1,224 @@
+package ddbpath
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// walkParent returns the value that holds the last element of path 'els' in 'item'. If 'create' is true
+// missing maps are created, otherwise nil is returned when any part of the path doesn't exist.
+func walkParent(item map[string]types.AttributeValue, els []PathElement, create bool) (types.AttributeValue, error) {
+	var in types.AttributeValue = &types.AttributeValueMemberM{Value: item}
+	for i := 0; i < len(els)-1; i++ {
+		el, next := els[i], els[i+1]
+		switch {
+		case el.Index == Wildcard:
+			return nil, fmt.Errorf("wildcards are not supported")
+		case el.Index >= 0:
+			l, ok := in.(*types.AttributeValueMemberL)
+			if !ok {
+				return nil, fmt.Errorf("expected L to index '%d', got: %T", el.Index, in)
+			}
+
+			if el.Index >= len(l.Value) {
+				if !create {
+					return nil, nil
+				}
+				return nil, fmt.Errorf("index '%d' is out of range for list of length %d", el.Index, len(l.Value))
+			}
+
+			in = l.Value[el.Index]
+		default:
+			m, ok := in.(*types.AttributeValueMemberM)
+			if !ok {
+				return nil, fmt.Errorf("expected M to select '%s', got: %T", el.Field, in)
+			}
+
+			v, ok := m.Value[el.Field]
+			switch {
+			case ok:
+			case !create:
+				return nil, nil
+			case next.Index != -1:
+				return nil, fmt.Errorf("no list to index at '%s', only maps are created", el.Field)
+			default:
+				v = &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}}
+				if m.Value == nil {
+					m.Value = map[string]types.AttributeValue{}
+				}
+				m.Value[el.Field] = v
+			}
+
+			in = v
+		}
+	}
+	return in, nil
+}
+
+// SetValue sets the value at 'path' in 'item' to 'av'. Maps that don't exist along the path are created.
+// An index may replace an element of a list or, if it equals the list's length, append to it.
+func SetValue(item map[string]types.AttributeValue, path string, av types.AttributeValue) error {
+	if item == nil {
+		return fmt.Errorf("failed to set '%s': item is nil", path)
+	}
+
+	els, err := ParsePath(path)
+	if err != nil {
+		return fmt.Errorf("failed to parse path '%s': %w", path, err)
+	}
+
+	if err = setValue(item, els, av); err != nil {
+		return fmt.Errorf("failed to set '%s': %w", path, err)
+	}
+	return nil
+}
+
+// setValue sets the value at parsed path 'els'
+func setValue(item map[string]types.AttributeValue, els []PathElement, av types.AttributeValue) error {
+	in, err := walkParent(item, els, true)
+	if err != nil {
+		return err
+	}
+
+	switch last := els[len(els)-1]; {
+	case last.Index == Wildcard:
+		return fmt.Errorf("wildcards are not supported")
+	case last.Index >= 0:
+		l, ok := in.(*types.AttributeValueMemberL)
+		switch {
+		case !ok:
+			return fmt.Errorf("expected L to index '%d', got: %T", last.Index, in)
+		case last.Index < len(l.Value):
+			l.Value[last.Index] = av
+		case last.Index == len(l.Value):
+			l.Value = append(l.Value, av)
+		default:
+			return fmt.Errorf("index '%d' is out of range for list of length %d", last.Index, len(l.Value))
+		}
+	default:
+		m, ok := in.(*types.AttributeValueMemberM)
+		if !ok {
+			return fmt.Errorf("expected M to select '%s', got: %T", last.Field, in)
+		}
+
+		if m.Value == nil {
+			m.Value = map[string]types.AttributeValue{}
+		}
+		m.Value[last.Field] = av
+	}
+	return nil
+}
+
+// DeleteValue deletes the value at 'path' from 'item'. Deleting an element from a list shifts the
+// elements after it, just like a REMOVE update. It does nothing if the value doesn't exist.
+func DeleteValue(item map[string]types.AttributeValue, path string) error {
+	els, err := ParsePath(path)
+	if err != nil {
+		return fmt.Errorf("failed to parse path '%s': %w", path, err)
+	}
+
+	if err = deleteValue(item, els); err != nil {
+		return fmt.Errorf("failed to delete '%s': %w", path, err)
+	}
+	return nil
+}
+
+// deleteValue deletes the value at parsed path 'els'
+func deleteValue(item map[string]types.AttributeValue, els []PathElement) error {
+	in, err := walkParent(item, els, false)
+	if err != nil || in == nil {
+		return err
+	}
+
+	switch last := els[len(els)-1]; {
+	case last.Index == Wildcard:
+		return fmt.Errorf("wildcards are not supported")
+	case last.Index >= 0:
+		l, ok := in.(*types.AttributeValueMemberL)
+		switch {
+		case !ok:
+			return fmt.Errorf("expected L to index '%d', got: %T", last.Index, in)
+		case last.Index < len(l.Value):
+			l.Value = append(l.Value[:last.Index], l.Value[last.Index+1:]...)
+		}
+	default:
+		m, ok := in.(*types.AttributeValueMemberM)
+		if !ok {
+			return fmt.Errorf("expected M to select '%s', got: %T", last.Field, in)
+		}
+		delete(m.Value, last.Field)
+	}
+	return nil
+}
+
+// MergeValues overlays the values at 'paths' in 'src' onto 'dst', like a mask on a partial update. Paths
+// without wildcards that don't exist in 'src' are deleted from 'dst'. Values are not copied, so 'dst'
+// will share them with 'src'.
+func MergeValues(dst, src map[string]types.AttributeValue, paths ...string) error {
+	if dst == nil {
+		return fmt.Errorf("failed to merge: dst is nil")
+	}
+
+	sel, err := SelectMapValues(src, paths...)
+	if err != nil {
+		return fmt.Errorf("failed to select values: %w", err)
+	}
+
+	var sets, dels [][]PathElement
+	vals := make(map[string]types.AttributeValue, len(sel))
+	for p, av := range sel {
+		els, err := ParsePath(p)
+		if err != nil {
+			return fmt.Errorf("failed to parse path '%s': %w", p, err)
+		}
+
+		sets = append(sets, els)
+		vals[FormatPath(els)] = av
+	}
+
+	for _, p := range paths {
+		els, err := ParsePath(p)
+		if err != nil {
+			return fmt.Errorf("failed to parse path '%s': %w", p, err)
+		}
+
+		if _, ok := sel[p]; !ok && !hasWildcard(els) {
+			dels = append(dels, els)
+		}
+	}
+
+	// delete in reverse order so removing a list element doesn't shift the elements that are deleted
+	// later. Set in order, so list elements are appended in order.
+	sortPaths(dels)
+	for i := len(dels) - 1; i >= 0; i-- {
+		if err = deleteValue(dst, dels[i]); err != nil {
+			return fmt.Errorf("failed to delete '%s': %w", FormatPath(dels[i]), err)
+		}
+	}
+
+	sortPaths(sets)
+	for _, els := range sets {
+		if err = setValue(dst, els, vals[FormatPath(els)]); err != nil {
+			return fmt.Errorf("failed to set '%s': %w", FormatPath(els), err)
+		}
+	}
+	return nil
+}
+
+// sortPaths sorts parsed paths element by element, with indexes in numeric order
+func sortPaths(ps [][]PathElement) {
+	sort.Slice(ps, func(i, j int) bool {
+		a, b := ps[i], ps[j]
+		for k := 0; k < len(a) && k < len(b); k++ {
+			switch {
+			case a[k].Index >= 0 && b[k].Index >= 0 && a[k].Index != b[k].Index:
+				return a[k].Index < b[k].Index
+			case a[k].Field != b[k].Field:
+				return a[k].Field < b[k].Field
+			}
+		}
+		return len(a) < len(b)
+	})
+}
@@ -0,0 +1,122 @@
+package ddbpath
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// exampleItem returns a new item to apply values to
+func exampleItem() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"1": &types.AttributeValueMemberS{Value: "foo"},
+		"m": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+			"a.b": &types.AttributeValueMemberN{Value: "1"},
+		}},
+		"l": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+			&types.AttributeValueMemberN{Value: "1"},
+			&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}},
+		}},
+	}
+}
+
+var _ = DescribeTable("set value", func(p string, av types.AttributeValue, expErr string, expSel map[string]types.AttributeValue) {
+	item := exampleItem()
+	err := SetValue(item, p, av)
+	if expErr != "" {
+		Expect(err).To(MatchError(MatchRegexp(expErr)))
+		return
+	}
+
+	Expect(err).ToNot(HaveOccurred())
+	sel, err := SelectMapValues(item, p)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(sel).To(Equal(expSel))
+},
+	Entry("top-level", ".1", &types.AttributeValueMemberS{Value: "bar"}, "",
+		map[string]types.AttributeValue{".1": &types.AttributeValueMemberS{Value: "bar"}}),
+	Entry("create maps", ".x.y.z", &types.AttributeValueMemberS{Value: "bar"}, "",
+		map[string]types.AttributeValue{".x.y.z": &types.AttributeValueMemberS{Value: "bar"}}),
+	Entry("quoted key", `.m."a.b"`, &types.AttributeValueMemberN{Value: "2"}, "",
+		map[string]types.AttributeValue{`.m."a.b"`: &types.AttributeValueMemberN{Value: "2"}}),
+	Entry("replace in list", ".l[0]", &types.AttributeValueMemberN{Value: "2"}, "",
+		map[string]types.AttributeValue{".l[0]": &types.AttributeValueMemberN{Value: "2"}}),
+	Entry("append to list", ".l[2]", &types.AttributeValueMemberN{Value: "3"}, "",
+		map[string]types.AttributeValue{".l[2]": &types.AttributeValueMemberN{Value: "3"}}),
+	Entry("into list element", ".l[1].x.y", &types.AttributeValueMemberN{Value: "3"}, "",
+		map[string]types.AttributeValue{".l[1].x.y": &types.AttributeValueMemberN{Value: "3"}}),
+	Entry("out of range", ".l[3]", &types.AttributeValueMemberN{Value: "3"}, `index '3' is out of range for list of length 2`, nil),
+	Entry("out of range parent", ".l[3].x", &types.AttributeValueMemberN{Value: "3"}, `index '3' is out of range`, nil),
+	Entry("no list created", ".x[0]", &types.AttributeValueMemberN{Value: "3"}, `no list to index at 'x'`, nil),
+	Entry("no list created parent", ".x[0].y", &types.AttributeValueMemberN{Value: "3"}, `no list to index at 'x'`, nil),
+	Entry("into scalar", ".1.x", &types.AttributeValueMemberN{Value: "3"}, `expected M to select 'x', got: \*types.AttributeValueMemberS`, nil),
+	Entry("wildcard", ".m.*", &types.AttributeValueMemberN{Value: "3"}, `wildcards are not supported`, nil),
+	Entry("invalid path", ".m.\"a", &types.AttributeValueMemberN{Value: "3"}, `failed to parse path`, nil),
+)
+
+var _ = DescribeTable("delete value", func(p string, expErr string, expItem func(map[string]types.AttributeValue)) {
+	item := exampleItem()
+	err := DeleteValue(item, p)
+	if expErr != "" {
+		Expect(err).To(MatchError(MatchRegexp(expErr)))
+		return
+	}
+
+	Expect(err).ToNot(HaveOccurred())
+	exp := exampleItem()
+	expItem(exp)
+	Expect(item).To(Equal(exp))
+},
+	Entry("top-level", ".1", "", func(m map[string]types.AttributeValue) { delete(m, "1") }),
+	Entry("quoted key", `.m."a.b"`, "", func(m map[string]types.AttributeValue) {
+		m["m"] = &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}}
+	}),
+	Entry("list element", ".l[0]", "", func(m map[string]types.AttributeValue) {
+		m["l"] = &types.AttributeValueMemberL{Value: []types.AttributeValue{
+			&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}},
+		}}
+	}),
+	Entry("not existing", ".x.y[5]", "", func(m map[string]types.AttributeValue) {}),
+	Entry("out of range", ".l[5]", "", func(m map[string]types.AttributeValue) {}),
+	Entry("into scalar", ".1.x", `expected M to select 'x'`, nil),
+	Entry("wildcard", ".l[*]", `wildcards are not supported`, nil),
+)
+
+var _ = Describe("merge values", func() {
+	It("should overlay selected values", func() {
+		dst := exampleItem()
+		src := map[string]types.AttributeValue{
+			"1": &types.AttributeValueMemberS{Value: "bar"},
+			"l": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+				&types.AttributeValueMemberN{Value: "5"},
+				&types.AttributeValueMemberN{Value: "6"},
+				&types.AttributeValueMemberN{Value: "7"},
+			}},
+			"n": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+				"x": &types.AttributeValueMemberN{Value: "8"},
+			}},
+		}
+
+		Expect(MergeValues(dst, src, ".1", ".l[*]", ".n.x", `.m."a.b"`)).To(Succeed())
+		Expect(dst).To(Equal(map[string]types.AttributeValue{
+			"1": &types.AttributeValueMemberS{Value: "bar"},
+			"m": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}},
+			"l": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+				&types.AttributeValueMemberN{Value: "5"},
+				&types.AttributeValueMemberN{Value: "6"},
+				&types.AttributeValueMemberN{Value: "7"},
+			}},
+			"n": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+				"x": &types.AttributeValueMemberN{Value: "8"},
+			}},
+		}))
+	})
+
+	It("should fail on nil dst", func() {
+		Expect(MergeValues(nil, exampleItem(), ".1")).To(MatchError(MatchRegexp(`dst is nil`)))
+	})
+
+	It("should fail on invalid path", func() {
+		Expect(MergeValues(exampleItem(), exampleItem(), ".1[")).To(MatchError(MatchRegexp(`failed to select values`)))
+	})
+})
@@ -3,6 +3,8 @@ package ddbpath
 import (
 	"fmt"
 	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
 )
 
 // ErrTypeNotRegistered is returned when a type is not registered in the registry being used
@@ -49,3 +51,15 @@ func (e ErrUnknownField) Error() string {
 func errUnknownField(field string, info FieldInfo) error {
 	return fmt.Errorf("%w", ErrUnknownField{field, info})
 }
+
+type ErrOperandMismatch struct {
+	typ  expression.DynamoDBAttributeType
+	info FieldInfo
+}
+
+func (e ErrOperandMismatch) Error() string {
+	return fmt.Sprintf("operand of type '%s' not allowed on %s of type '%s'", e.typ, e.info, e.info.AttributeType)
+}
+func errOperandMismatch(typ expression.DynamoDBAttributeType, info FieldInfo) error {
+	return fmt.Errorf("%w", ErrOperandMismatch{typ, info})
+}
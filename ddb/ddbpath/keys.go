@@ -0,0 +1,129 @@
+package ddbpath
+
+import (
+	"bytes"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+)
+
+// PartitionKeyCond builds key conditions for the items in a single partition of a table or index
+type PartitionKeyCond struct {
+	index string
+	pk    expression.KeyConditionBuilder
+}
+
+// NewPartitionKeyCond inits a key condition for the partition where attribute 'pk' equals 'pv'. The
+// 'index' is the name of the secondary index to query, or empty for the table itself.
+func NewPartitionKeyCond(index, pk string, pv any) PartitionKeyCond {
+	return PartitionKeyCond{index: index, pk: expression.Key(pk).Equal(expression.Value(pv))}
+}
+
+// IndexName returns the name of the index the condition applies to, or nil for the table itself. It
+// can be set on the QueryInput directly.
+func (c PartitionKeyCond) IndexName() *string {
+	if c.index == "" {
+		return nil
+	}
+	return &c.index
+}
+
+// KeyCondition returns the condition that selects all items in the partition
+func (c PartitionKeyCond) KeyCondition() expression.KeyConditionBuilder {
+	return c.pk
+}
+
+// KeyCond builds key conditions for the items in a single partition of a table or index that has a
+// sort key with values of type T.
+type KeyCond[T any] struct {
+	PartitionKeyCond
+	sk expression.KeyBuilder
+}
+
+// NewKeyCond inits a key condition for the partition where attribute 'pk' equals 'pv' and sort key 'sk'
+func NewKeyCond[T any](index, pk string, pv any, sk string) KeyCond[T] {
+	return KeyCond[T]{PartitionKeyCond: NewPartitionKeyCond(index, pk, pv), sk: expression.Key(sk)}
+}
+
+// SortKeyEqual returns a condition that selects the item in the partition with sort key 'v'
+func (c KeyCond[T]) SortKeyEqual(v T) expression.KeyConditionBuilder {
+	return c.pk.And(c.sk.Equal(expression.Value(v)))
+}
+
+// SortKeyLessThan returns a condition that selects items in the partition with a sort key less than 'v'
+func (c KeyCond[T]) SortKeyLessThan(v T) expression.KeyConditionBuilder {
+	return c.pk.And(c.sk.LessThan(expression.Value(v)))
+}
+
+// SortKeyLessThanEqual returns a condition that selects items in the partition with a sort key less than
+// or equal to 'v'
+func (c KeyCond[T]) SortKeyLessThanEqual(v T) expression.KeyConditionBuilder {
+	return c.pk.And(c.sk.LessThanEqual(expression.Value(v)))
+}
+
+// SortKeyGreaterThan returns a condition that selects items in the partition with a sort key greater
+// than 'v'
+func (c KeyCond[T]) SortKeyGreaterThan(v T) expression.KeyConditionBuilder {
+	return c.pk.And(c.sk.GreaterThan(expression.Value(v)))
+}
+
+// SortKeyGreaterThanEqual returns a condition that selects items in the partition with a sort key
+// greater than or equal to 'v'
+func (c KeyCond[T]) SortKeyGreaterThanEqual(v T) expression.KeyConditionBuilder {
+	return c.pk.And(c.sk.GreaterThanEqual(expression.Value(v)))
+}
+
+// SortKeyBetween returns a condition that selects items in the partition with a sort key between
+// 'lower' and 'upper', inclusive
+func (c KeyCond[T]) SortKeyBetween(lower, upper T) expression.KeyConditionBuilder {
+	return c.pk.And(c.sk.Between(expression.Value(lower), expression.Value(upper)))
+}
+
+// StringKeyCond builds key conditions for a partition with a string sort key
+type StringKeyCond struct{ KeyCond[string] }
+
+// NewStringKeyCond inits a key condition for the partition where attribute 'pk' equals 'pv' and string
+// sort key 'sk'
+func NewStringKeyCond(index, pk string, pv any, sk string) StringKeyCond {
+	return StringKeyCond{NewKeyCond[string](index, pk, pv, sk)}
+}
+
+// SortKeyBeginsWith returns a condition that selects items in the partition with a sort key that
+// starts with 'prefix'
+func (c StringKeyCond) SortKeyBeginsWith(prefix string) expression.KeyConditionBuilder {
+	return c.pk.And(c.sk.BeginsWith(prefix))
+}
+
+// maxSortKeySize is the maximum size of a sort key value that DynamoDB allows
+const maxSortKeySize = 1024
+
+// BytesKeyCond builds key conditions for a partition with a binary sort key
+type BytesKeyCond struct{ KeyCond[[]byte] }
+
+// NewBytesKeyCond inits a key condition for the partition where attribute 'pk' equals 'pv' and binary
+// sort key 'sk'
+func NewBytesKeyCond(index, pk string, pv any, sk string) BytesKeyCond {
+	return BytesKeyCond{NewKeyCond[[]byte](index, pk, pv, sk)}
+}
+
+// SortKeyBeginsWith returns a condition that selects items in the partition with a sort key that
+// starts with 'prefix'. The expression package only builds begins_with with a string operand, so
+// it selects the same items with a range from 'prefix' up to 'prefix' padded with 0xFF bytes to the
+// maximum sort key size.
+func (c BytesKeyCond) SortKeyBeginsWith(prefix []byte) expression.KeyConditionBuilder {
+	if len(prefix) == 0 {
+		return c.pk
+	}
+
+	lower, upper := prefixRange(prefix)
+	return c.pk.And(c.sk.Between(expression.Value(lower), expression.Value(upper)))
+}
+
+// prefixRange returns the inclusive range of binary values up to the maximum sort key size that start
+// with 'prefix': from 'prefix' itself up to 'prefix' padded with 0xFF bytes.
+func prefixRange(prefix []byte) (lower, upper []byte) {
+	upper = append([]byte{}, prefix...)
+	if len(upper) < maxSortKeySize {
+		upper = append(upper, bytes.Repeat([]byte{0xFF}, maxSortKeySize-len(upper))...)
+	}
+	return prefix, upper
+}
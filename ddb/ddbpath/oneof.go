@@ -0,0 +1,31 @@
+package ddbpath
+
+import (
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+)
+
+// SetOneof returns an update that applies 'u' to set a member of a oneof and removes the 'others'
+// members. Members are stored as sibling attributes so this makes sure at most one of them is set.
+func SetOneof(u Update, others ...expression.NameBuilder) Update {
+	return func(ub expression.UpdateBuilder) expression.UpdateBuilder {
+		ub = u(ub)
+		for _, o := range others {
+			ub = ub.Remove(o)
+		}
+		return ub
+	}
+}
+
+// WhichOneof returns a condition that checks if 'member' is the member of a oneof that is set, by
+// checking that its attribute exists while the attributes of the 'others' members don't.
+func WhichOneof(member expression.NameBuilder, others ...expression.NameBuilder) expression.ConditionBuilder {
+	if len(others) < 1 {
+		return member.AttributeExists()
+	}
+
+	conds := make([]expression.ConditionBuilder, 0, len(others))
+	for _, o := range others {
+		conds = append(conds, o.AttributeNotExists())
+	}
+	return expression.And(member.AttributeExists(), conds[0], conds[1:]...)
+}
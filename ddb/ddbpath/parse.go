@@ -52,9 +52,14 @@ func (l *lexer) errorf(format string, v ...any) stateFn {
 // stateFn represents a lexer state. It returns the mutated lexer and a new state.
 type stateFn func(l lexer) (lexer, stateFn)
 
-// append the captured field key into the result
+// append the captured field key into the result, an unquoted '*' is a wildcard
 func emitField(l lexer) (lexer, stateFn) {
-	l.res = append(l.res, PathElement{l.input[l.start:l.pos], -1})
+	part := PathElement{l.input[l.start:l.pos], -1}
+	if part.Field == "*" {
+		part.Index = Wildcard
+	}
+
+	l.res = append(l.res, part)
 	return l, lexDotOrBracket
 }
 
@@ -78,6 +83,11 @@ func emitIndex(l lexer) (lexer, stateFn) {
 
 // parse a string key into a map
 func lexField(l lexer) (lexer, stateFn) {
+	if strings.HasPrefix(l.input[l.pos:], `"`) {
+		l.next()
+		return l, lexQuoted
+	}
+
 	for {
 		switch l.next() {
 		case eof:
@@ -89,8 +99,51 @@ func lexField(l lexer) (lexer, stateFn) {
 	}
 }
 
+// parse a double-quoted string key, a backslash escapes the rune that follows it
+func lexQuoted(l lexer) (lexer, stateFn) {
+	var escaped bool
+	for {
+		switch l.next() {
+		case eof:
+			return l, l.errorf("unexpected end of quoted field, expected '\"'")
+		case '\\':
+			if l.next() == eof {
+				return l, l.errorf("unexpected end of quoted field, expected escaped character")
+			}
+			escaped = true
+		case '"':
+			field := l.input[l.start+1 : l.pos-1]
+			if escaped {
+				field = unescapeField(field)
+			}
+
+			l.res = append(l.res, PathElement{field, -1})
+			return l, lexDotOrBracket
+		}
+	}
+}
+
+// unescapeField removes the escaping backslashes from a quoted field
+func unescapeField(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
 // parse an index into a list or set
 func lexIndex(l lexer) (lexer, stateFn) {
+	if strings.HasPrefix(l.input[l.pos:], "*]") {
+		l.pos += 2
+		l.res = append(l.res, PathElement{"*", Wildcard})
+		return l, lexDotOrBracket
+	}
+
 	for {
 		r := l.next()
 		switch r {
@@ -131,19 +184,27 @@ func lexStart(l lexer) (lexer, stateFn) {
 	case '[':
 		l.ignore()
 		return l, lexIndex
+	case '"':
+		return l, lexQuoted
 	default:
 		return l, lexField
 	}
 }
 
-// PathElement describes a part of the path. It is either an numeric index into a list (or set)
-// or it is a string key into the field.
+// PathElement describes a part of the path. It is either an numeric index into a list (or set),
+// a string key into the field or a wildcard.
 type PathElement struct {
 	Field string
 	Index int
 }
 
-// ParsePath will parse 'p' in its elements and return them.
+// Wildcard is the index of a path element that selects every value of a map, list or set. It is
+// written as '.*' or '[*]' in a path, a quoted '"*"' selects the literal '*' key instead.
+const Wildcard = -2
+
+// ParsePath will parse 'p' in its elements and return them. Field elements that contain dots, brackets
+// or quotes can be double-quoted, e.g: '.calendar."a.b@c.com"'. Inside the quotes a backslash escapes
+// the character that follows it.
 func ParsePath(p string) ([]PathElement, error) {
 	return AppendParsePath(p, nil)
 }
@@ -158,55 +219,170 @@ func AppendParsePath(p string, r []PathElement) ([]PathElement, error) {
 	return l.res, l.err
 }
 
-func selectValue(in types.AttributeValue, els []PathElement) (out types.AttributeValue, err error) {
+// FormatPath formats path elements 'els' into a path that parses back into the same elements. Fields
+// are quoted only when necessary.
+func FormatPath(els []PathElement) string {
+	var b strings.Builder
+	for _, el := range els {
+		if el.Index == Wildcard {
+			b.WriteString(".*")
+			continue
+		}
+
+		if el.Index >= 0 {
+			b.WriteString("[" + strconv.Itoa(el.Index) + "]")
+			continue
+		}
+
+		b.WriteByte('.')
+		if el.Field != "" && el.Field != "*" && !strings.ContainsAny(el.Field, `.[]"\`) {
+			b.WriteString(el.Field)
+			continue
+		}
+
+		b.WriteByte('"')
+		for i := 0; i < len(el.Field); i++ {
+			if el.Field[i] == '"' || el.Field[i] == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(el.Field[i])
+		}
+		b.WriteByte('"')
+	}
+	return b.String()
+}
+
+// selectValue selects the value at path 'els' from 'in' and calls 'fn' with it, and with its concrete
+// path appended to 'at'. Wildcard elements fan out so 'fn' may be called many times. Values that don't
+// exist, or indexes that are out of range, are not selected.
+func selectValue(
+	in types.AttributeValue, els, at []PathElement, fn func(at []PathElement, out types.AttributeValue),
+) error {
 	var field string
 	var index int
 	for i := 0; i < len(els); i++ {
 		field, index = els[i].Field, els[i].Index
-		if index >= 0 {
-			switch tin := in.(type) {
-			case *types.AttributeValueMemberL:
-				in = tin.Value[index]
-			case *types.AttributeValueMemberSS:
-				in = &types.AttributeValueMemberS{Value: tin.Value[index]}
-			case *types.AttributeValueMemberBS:
-				in = &types.AttributeValueMemberB{Value: tin.Value[index]}
-			case *types.AttributeValueMemberNS:
-				in = &types.AttributeValueMemberN{Value: tin.Value[index]}
-			default:
-				return nil, fmt.Errorf("expected L, SS, BS, or NS, got: %T", tin)
+		switch {
+		case index == Wildcard:
+			return selectWildcard(in, els[i+1:], at, fn)
+		case index >= 0:
+			var ok bool
+			if in, ok = selectIndex(in, index); !ok {
+				return fmt.Errorf("expected L, SS, BS, or NS, got: %T", in)
+			} else if in == nil {
+				return nil
 			}
-		} else if m, ok := in.(*types.AttributeValueMemberM); ok {
-			in, ok = m.Value[field]
+		default:
+			m, ok := in.(*types.AttributeValueMemberM)
 			if !ok {
-				return nil, nil
+				return fmt.Errorf("unsupported select %v/%v for: %T", field, index, in)
 			}
-		} else {
-			return nil, fmt.Errorf("unsupported select %v/%v for: %T", field, index, in)
+
+			if in, ok = m.Value[field]; !ok {
+				return nil
+			}
+		}
+
+		at = append(at, els[i])
+	}
+
+	fn(at, in)
+	return nil
+}
+
+// selectIndex selects the value at 'index' of a list or set. It returns false if 'in' cannot be indexed
+// and a nil value if the index is out of range.
+func selectIndex(in types.AttributeValue, index int) (types.AttributeValue, bool) {
+	switch tin := in.(type) {
+	case *types.AttributeValueMemberL:
+		if index < len(tin.Value) {
+			return tin.Value[index], true
+		}
+	case *types.AttributeValueMemberSS:
+		if index < len(tin.Value) {
+			return &types.AttributeValueMemberS{Value: tin.Value[index]}, true
+		}
+	case *types.AttributeValueMemberBS:
+		if index < len(tin.Value) {
+			return &types.AttributeValueMemberB{Value: tin.Value[index]}, true
+		}
+	case *types.AttributeValueMemberNS:
+		if index < len(tin.Value) {
+			return &types.AttributeValueMemberN{Value: tin.Value[index]}, true
+		}
+	default:
+		return in, false
+	}
+	return nil, true
+}
+
+// selectWildcard selects path 'els' from every value in map, list or set 'in'
+func selectWildcard(
+	in types.AttributeValue, els, at []PathElement, fn func(at []PathElement, out types.AttributeValue),
+) (err error) {
+	if m, ok := in.(*types.AttributeValueMemberM); ok {
+		for k, v := range m.Value {
+			if err = selectValue(v, els, append(at, PathElement{k, -1}), fn); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for i := 0; ; i++ {
+		v, ok := selectIndex(in, i)
+		switch {
+		case !ok:
+			return fmt.Errorf("expected M, L, SS, BS, or NS for wildcard, got: %T", in)
+		case v == nil:
+			return nil
+		}
+
+		if err = selectValue(v, els, append(at, PathElement{strconv.Itoa(i), i}), fn); err != nil {
+			return err
 		}
 	}
-	return in, nil
+}
+
+// hasWildcard returns whether any of the path elements is a wildcard
+func hasWildcard(els []PathElement) bool {
+	for _, el := range els {
+		if el.Index == Wildcard {
+			return true
+		}
+	}
+	return false
 }
 
 // SelectValues will return a subset of a composite attribute value 'v' (maps or sets), specified by 'paths'.
 // This is usefull when only part of a DynamoDB item is allowed or desired for an operation. For example
-// when only the keys need to be selected, or a partial update is performed using a mask.
+// when only the keys need to be selected, or a partial update is performed using a mask. Paths that
+// don't exist, including out-of-range indexes, are not part of the result. Paths with wildcards select
+// every match, keyed by its concrete path as formatted by FormatPath. Other paths are keyed by the path
+// as it was passed in.
 func SelectValues(v types.AttributeValue, paths ...string) (res map[string]types.AttributeValue, err error) {
 	res = make(map[string]types.AttributeValue, len(paths))
-	els := make([]PathElement, 32) // allocate space for upto 32 element deep paths, this Dynamo's max
-	for _, p := range paths {
+	buf := make([]PathElement, 64) // space for upto 32 element deep paths, this Dynamo's max, and selected paths
+	els, at := buf[:32:32], buf[32:]
+
+	var p string
+	var wildcard bool
+	emit := func(at []PathElement, r types.AttributeValue) {
+		if wildcard {
+			res[FormatPath(at)] = r
+		} else {
+			res[p] = r
+		}
+	}
+
+	for _, p = range paths {
 		if els, err = AppendParsePath(p, els[:0]); err != nil {
 			return nil, fmt.Errorf("failed to parse plath '%s': %w", p, err)
 		}
 
-		r, err := selectValue(v, els)
-		switch {
-		case err != nil:
+		wildcard = hasWildcard(els)
+		if err = selectValue(v, els, at[:0], emit); err != nil {
 			return nil, fmt.Errorf("failed to select values: %w", err)
-		case r == nil:
-			continue
-		default:
-			res[p] = r
 		}
 	}
 	return
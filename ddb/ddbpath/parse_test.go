@@ -1,6 +1,7 @@
 package ddbpath
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
@@ -28,6 +29,45 @@ var _ = DescribeTable("parse path", func(s string, expParts []PathElement, expEr
 	Entry("3", "foo.bar.dar", []PathElement{{"foo", -1}, {"bar", -1}, {"dar", -1}}, nil),
 )
 
+var _ = DescribeTable("parse quoted path", func(s string, expParts []PathElement, expErr string) {
+	parts, err := ParsePath(s)
+	if expErr != "" {
+		Expect(err).To(MatchError(MatchRegexp(expErr)))
+		return
+	}
+
+	Expect(err).ToNot(HaveOccurred())
+	Expect(parts).To(Equal(expParts))
+},
+	Entry("quoted map key", `.calendar."a.b@c.com"`, []PathElement{{"calendar", -1}, {"a.b@c.com", -1}}, ""),
+	Entry("quoted first", `"a.b"[1]`, []PathElement{{"a.b", -1}, {"1", 1}}, ""),
+	Entry("quoted brackets", `.m."x[1]".y`, []PathElement{{"m", -1}, {"x[1]", -1}, {"y", -1}}, ""),
+	Entry("escapes", `.m."a\"b\\c"`, []PathElement{{"m", -1}, {`a"b\c`, -1}}, ""),
+	Entry("empty quoted", `.m.""`, []PathElement{{"m", -1}, {"", -1}}, ""),
+	Entry("unterminated", `.m."a.b`, nil, `unexpected end of quoted field`),
+	Entry("dangling escape", `.m."a\`, nil, `expected escaped character`),
+	Entry("trailing chars", `.m."a"b`, nil, `expected dot or bracket`),
+	Entry("wildcards", `.furniture.*.1[*]`, []PathElement{{"furniture", -1}, {"*", Wildcard}, {"1", -1}, {"*", Wildcard}}, ""),
+	Entry("wildcard first", `*.a`, []PathElement{{"*", Wildcard}, {"a", -1}}, ""),
+	Entry("quoted star", `.m."*"`, []PathElement{{"m", -1}, {"*", -1}}, ""),
+	Entry("wildcard prefix", `[*1]`, nil, `indexing requires digit`),
+)
+
+var _ = DescribeTable("format path", func(els []PathElement, exp string) {
+	Expect(FormatPath(els)).To(Equal(exp))
+
+	parsed, err := ParsePath(exp)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(parsed).To(Equal(els))
+},
+	Entry("plain", []PathElement{{"foo", -1}, {"1", 1}, {"bar", -1}}, `.foo[1].bar`),
+	Entry("dotted key", []PathElement{{"calendar", -1}, {"a.b@c.com", -1}}, `.calendar."a.b@c.com"`),
+	Entry("brackets", []PathElement{{"x[1]", -1}, {"y]", -1}}, `."x[1]"."y]"`),
+	Entry("escapes", []PathElement{{`a"b\c`, -1}}, `."a\"b\\c"`),
+	Entry("empty", []PathElement{{"", -1}, {"0", 0}}, `.""[0]`),
+	Entry("wildcard", []PathElement{{"m", -1}, {"*", Wildcard}, {"*", -1}}, `.m.*."*"`),
+)
+
 var _ = Describe("select map values", func() {
 	It("should select", func() {
 		av := map[string]types.AttributeValue{"foo": &types.AttributeValueMemberN{Value: "100"}}
@@ -43,7 +83,7 @@ var _ = Describe("select map values", func() {
 var _ = DescribeTable("select values", func(av types.AttributeValue, paths []string, expVals map[string]types.AttributeValue, expErr error) {
 	vals, err := SelectValues(av, paths...)
 	if expErr != nil {
-		Expect(err).To(Equal(err))
+		Expect(err).To(MatchError(ContainSubstring(expErr.Error())))
 	} else {
 		Expect(err).To(BeNil())
 	}
@@ -64,6 +104,76 @@ var _ = DescribeTable("select values", func(av types.AttributeValue, paths []str
 		},
 		nil,
 	),
+	Entry("keyed by the path passed in, or the formatted path of wildcard matches",
+		&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+			"foo": &types.AttributeValueMemberN{Value: "100"},
+			"l":   &types.AttributeValueMemberL{Value: []types.AttributeValue{&types.AttributeValueMemberN{Value: "1"}}},
+		}},
+		[]string{"foo", `."l"[0]`, `."l"[*]`},
+		map[string]types.AttributeValue{
+			"foo":     &types.AttributeValueMemberN{Value: "100"},
+			`."l"[0]`: &types.AttributeValueMemberN{Value: "1"},
+			".l[0]":   &types.AttributeValueMemberN{Value: "1"},
+		},
+		nil,
+	),
+	Entry("quoted map key",
+		&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{"calendar": &types.AttributeValueMemberM{
+			Value: map[string]types.AttributeValue{"a.b@c.com": &types.AttributeValueMemberN{Value: "100"}},
+		}}},
+		[]string{`.calendar."a.b@c.com"`},
+		map[string]types.AttributeValue{
+			`.calendar."a.b@c.com"`: &types.AttributeValueMemberN{Value: "100"},
+		},
+		nil,
+	),
+	Entry("index out of range",
+		&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+			"l":  &types.AttributeValueMemberL{Value: []types.AttributeValue{&types.AttributeValueMemberN{Value: "1"}}},
+			"ss": &types.AttributeValueMemberSS{Value: []string{"a"}},
+		}},
+		[]string{".l[1]", ".ss[5]", ".l[0]"},
+		map[string]types.AttributeValue{
+			".l[0]": &types.AttributeValueMemberN{Value: "1"},
+		},
+		nil,
+	),
+	Entry("wildcard map",
+		&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{"furniture": &types.AttributeValueMemberM{
+			Value: map[string]types.AttributeValue{
+				"chair":   &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{"1": &types.AttributeValueMemberS{Value: "wood"}}},
+				"a.b":     &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{"1": &types.AttributeValueMemberS{Value: "steel"}}},
+				"no-name": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}},
+			},
+		}}},
+		[]string{".furniture.*.1"},
+		map[string]types.AttributeValue{
+			".furniture.chair.1": &types.AttributeValueMemberS{Value: "wood"},
+			`.furniture."a.b".1`: &types.AttributeValueMemberS{Value: "steel"},
+		},
+		nil,
+	),
+	Entry("wildcard list and set",
+		&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+			"l": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+				&types.AttributeValueMemberN{Value: "1"}, &types.AttributeValueMemberN{Value: "2"},
+			}},
+			"ns": &types.AttributeValueMemberNS{Value: []string{"3"}},
+		}},
+		[]string{".l[*]", ".ns.*", ".empty[*]"},
+		map[string]types.AttributeValue{
+			".l[0]":  &types.AttributeValueMemberN{Value: "1"},
+			".l[1]":  &types.AttributeValueMemberN{Value: "2"},
+			".ns[0]": &types.AttributeValueMemberN{Value: "3"},
+		},
+		nil,
+	),
+	Entry("wildcard on scalar",
+		&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{"n": &types.AttributeValueMemberN{Value: "1"}}},
+		[]string{".n.*"},
+		nil,
+		fmt.Errorf("expected M, L, SS, BS, or NS for wildcard"),
+	),
 )
 
 var res []PathElement
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"google.golang.org/protobuf/proto"
 )
 
 // List of basic type(s)
@@ -15,6 +16,11 @@ func (p List) Index(i int) expression.NameBuilder {
 	return p.AppendName(expression.Name(fmt.Sprintf(`[%d]`, i)))
 }
 
+// Remove returns an update that removes the list
+func (p List) Remove() Update {
+	return remove(p.NameBuilder)
+}
+
 // ItemList is a list of nested items
 type ItemList[T interface {
 	WithDynamoNameBuilder(expression.NameBuilder) T
@@ -26,6 +32,17 @@ func (p ItemList[T]) Index(i int) T {
 	return v.WithDynamoNameBuilder(p.AppendName(expression.Name(fmt.Sprintf(`[%d]`, i))))
 }
 
+// Remove returns an update that removes the list
+func (p ItemList[T]) Remove() Update {
+	return remove(p.NameBuilder)
+}
+
+// ListAppend returns an update that appends messages 'xs' to the list. The messages must be of the
+// type that the item paths select, this is checked when the expression is build.
+func (p ItemList[T]) ListAppend(xs ...proto.Message) Update {
+	return set(p.NameBuilder, p.NameBuilder.ListAppend(listOperand(messageName[T](), xs)))
+}
+
 // Map of basic type(s)
 type Map struct{ expression.NameBuilder }
 
@@ -34,6 +51,11 @@ func (p Map) Key(k string) expression.NameBuilder {
 	return p.AppendName(expression.Name(k))
 }
 
+// Remove returns an update that removes the map
+func (p Map) Remove() Update {
+	return remove(p.NameBuilder)
+}
+
 // ItemMap is a list of nested items
 type ItemMap[T interface {
 	WithDynamoNameBuilder(expression.NameBuilder) T
@@ -45,6 +67,11 @@ func (p ItemMap[T]) Key(k string) T {
 	return v.WithDynamoNameBuilder(p.AppendName(expression.Name(k)))
 }
 
+// Remove returns an update that removes the map
+func (p ItemMap[T]) Remove() Update {
+	return remove(p.NameBuilder)
+}
+
 // register list and map
 func init() {
 	Register(List{}, map[string]FieldInfo{})
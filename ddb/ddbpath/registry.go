@@ -5,6 +5,10 @@ import (
 	"reflect"
 
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	ddbv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
 // NameBuilder inteface is implemented by generated name building structs
@@ -44,8 +48,15 @@ func (fi FieldKind) String() string {
 
 // FieldInfo of a field on a message
 type FieldInfo struct {
-	Kind    FieldKind    // list, map, basic, any etc
-	Message reflect.Type // field holds a non-basic type, or nil if its a basic type
+	Kind              FieldKind                        // list, map, basic, any etc
+	Message           reflect.Type                     // field holds a non-basic type, or nil if its a basic type
+	AttributeType     expression.DynamoDBAttributeType // type of the stored attribute, or empty if it can be any type
+	ElemAttributeType expression.DynamoDBAttributeType // type of list and map elements, or empty if they can be any type
+	Set               bool                             // repeated field that is stored as a set
+	Embed             ddbv1.Encoding                   // embed encoding of the field, such as JSON
+	Presence          bool                             // field has explicit presence, its attribute may not exist
+	FullName          protoreflect.FullName            // full name of the proto field
+	Codec             string                           // name of the codec that stores the field, if any
 }
 
 // NoInfo is the FieldInfo zero value
@@ -53,10 +64,48 @@ var NoInfo = FieldInfo{}
 
 // String returns a human readable form of the field info
 func (fi FieldInfo) String() string {
-	if fi.Message == nil {
-		return fi.Kind.String()
+	s := fi.Kind.String()
+	if fi.Message != nil {
+		s = fmt.Sprintf("%s<%s>", fi.Kind, fi.Message)
+	}
+
+	switch {
+	case fi.IsEmbedded():
+		return fmt.Sprintf("%s(embedded %s)", s, fi.Embed)
+	case fi.Codec != "":
+		return fmt.Sprintf("%s(codec %s)", s, fi.Codec)
+	case fi.Set:
+		return fmt.Sprintf("%s(%s)", s, fi.AttributeType)
+	default:
+		return s
 	}
-	return fmt.Sprintf("%s<%s>", fi.Kind, fi.Message)
+}
+
+// IsEmbedded returns whether the field is stored with an embed encoding, so it cannot be traversed
+func (fi FieldInfo) IsEmbedded() bool {
+	return fi.Embed != ddbv1.Encoding_ENCODING_UNSPECIFIED && fi.Embed != ddbv1.Encoding_ENCODING_DYNAMO
+}
+
+// IsOpaque returns whether the field's attribute has an encoding that paths cannot select into, because
+// it is embedded or stored by a codec.
+func (fi FieldInfo) IsOpaque() bool {
+	return fi.IsEmbedded() || fi.Codec != ""
+}
+
+// Descriptor returns the descriptor of the proto field from the global registry, or nil if the field's
+// file is not registered. It is resolved lazily since path packages cannot import the messages.
+func (fi FieldInfo) Descriptor() protoreflect.FieldDescriptor {
+	if fi.FullName == "" {
+		return nil
+	}
+
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(fi.FullName)
+	if err != nil {
+		return nil
+	}
+
+	fd, _ := d.(protoreflect.FieldDescriptor)
+	return fd
 }
 
 // Registry holds type information so validation of string paths can happen efficiently
@@ -124,6 +173,21 @@ func (r Registry) Validate(nb NameBuilder, paths ...string) (err error) {
 	return
 }
 
+// ValidateOperand validates that value 'av' can be used as an operand in conditions on the attribute at
+// path 'p', given the types in the registry. The value must be of the attribute's type, or of the type of
+// its elements for sets.
+func (r Registry) ValidateOperand(nb NameBuilder, p string, av types.AttributeValue) error {
+	fi, _, err := r.Traverse(nb, p)
+	if err != nil {
+		return fmt.Errorf("failed to traverse path '%s': %w", p, err)
+	}
+
+	if err = validateOperand(fi, av); err != nil {
+		return fmt.Errorf("invalid operand for path '%s': %w", p, err)
+	}
+	return nil
+}
+
 // defaultRegistry allows validation agains the default registry
 var defaultRegistery = NewRegistry()
 
@@ -133,6 +197,14 @@ func Validate(nb NameBuilder, paths ...string) error {
 	return defaultRegistery.Validate(nb, paths...)
 }
 
+// DefaultRegistry returns the registry that generated name building structs register with
+func DefaultRegistry() Registry { return defaultRegistery }
+
+// ValidateOperand validates value 'av' as operand for the attribute at path 'p' against the default registry
+func ValidateOperand(nb NameBuilder, p string, av types.AttributeValue) error {
+	return defaultRegistery.ValidateOperand(nb, p, av)
+}
+
 // Register a generated name building struct with the default registry. It panics if the
 // type is already registered.
 func Register(nb NameBuilder, fields map[string]FieldInfo) { defaultRegistery.Register(nb, fields) }
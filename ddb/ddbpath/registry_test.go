@@ -1,14 +1,19 @@
 package ddbpath_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
 	"github.com/crewlinker/protoc-gen-dynamodb/ddb/ddbpath"
+	ddbv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+	_ "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1"
 	messagev1ddbpath "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1/ddbpath"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 var _ = Describe("strings", func() {
@@ -31,7 +36,22 @@ var _ = Describe("strings", func() {
 		Expect(k.String()).To(Equal(exp))
 	},
 		Entry("1", ddbpath.FieldInfo{Kind: ddbpath.FieldKindList}, "List"),
-		Entry("1", ddbpath.FieldInfo{Kind: ddbpath.FieldKindList, Message: reflect.TypeOf(messagev1ddbpath.Kitchen())}, "List<messagev1ddbpath.KitchenPath>"))
+		Entry("1", ddbpath.FieldInfo{Kind: ddbpath.FieldKindList, Message: reflect.TypeOf(messagev1ddbpath.Kitchen())}, "List<messagev1ddbpath.KitchenPath>"),
+		Entry("set", ddbpath.FieldInfo{Kind: ddbpath.FieldKindList, AttributeType: expression.StringSet, Set: true}, "List(SS)"),
+		Entry("embedded", ddbpath.FieldInfo{Kind: ddbpath.FieldKindSingle, Embed: ddbv1.Encoding_ENCODING_JSON}, "Single(embedded ENCODING_JSON)"),
+		Entry("dynamo embedded", ddbpath.FieldInfo{Kind: ddbpath.FieldKindSingle, Embed: ddbv1.Encoding_ENCODING_DYNAMO}, "Single"))
+
+	It("should resolve field descriptors", func() {
+		fields, ok := ddbpath.DefaultRegistry().FieldsOf(messagev1ddbpath.Kitchen())
+		Expect(ok).To(BeTrue())
+		Expect(fields["1"].FullName).To(Equal(protoreflect.FullName("example.message.v1.Kitchen.brand")))
+		Expect(fields["1"].Descriptor()).ToNot(BeNil())
+		Expect(fields["1"].Descriptor().Number()).To(Equal(protoreflect.FieldNumber(1)))
+
+		Expect(ddbpath.FieldInfo{}.Descriptor()).To(BeNil())
+		Expect(ddbpath.FieldInfo{FullName: "foo.Bar.baz"}.Descriptor()).To(BeNil())
+		Expect(ddbpath.FieldInfo{FullName: "example.message.v1.Kitchen"}.Descriptor()).To(BeNil())
+	})
 })
 
 var _ = Describe("validate", func() {
@@ -89,6 +109,7 @@ var _ = Describe("validate", func() {
 			Entry("message field", messagev1ddbpath.Kitchen(), "16", ``, ddbpath.FieldInfo{Kind: ddbpath.FieldKindSingle, Message: reflect.TypeOf(messagev1ddbpath.Kitchen())}),
 			Entry("list of messages", messagev1ddbpath.Kitchen(), "17", ``, ddbpath.FieldInfo{Kind: ddbpath.FieldKindList, Message: reflect.TypeOf(messagev1ddbpath.Kitchen())}),
 			Entry("map of messages", messagev1ddbpath.Kitchen(), "16.16.19", ``, ddbpath.FieldInfo{Kind: ddbpath.FieldKindMap, Message: reflect.TypeOf(messagev1ddbpath.Kitchen())}),
+			Entry("quoted map key", messagev1ddbpath.Kitchen(), `19."a.b@c.com"`, ``, ddbpath.FieldInfo{Kind: ddbpath.FieldKindSingle, Message: reflect.TypeOf(messagev1ddbpath.Kitchen()), AttributeType: expression.Map}),
 		)
 
 		DescribeTable("validation", func(nb ddbpath.NameBuilder, p string, expError string) {
@@ -115,6 +136,14 @@ var _ = Describe("validate", func() {
 			Entry("map of messages", messagev1ddbpath.Kitchen(), "19.foo.16.1", ``),
 			Entry("map of basic", messagev1ddbpath.Kitchen(), "20.foo", ``),
 			Entry("map of basic", messagev1ddbpath.Kitchen(), "20.foo.999", `field selecting '999' not allowed on Single`),
+			Entry("quoted map key", messagev1ddbpath.Kitchen(), `19."a.b@c.com".16.1`, ``),
+			Entry("quoted field", messagev1ddbpath.Kitchen(), `"16"."1"`, ``),
+			Entry("unterminated quote", messagev1ddbpath.Kitchen(), `19."a.b`, `failed to parse path`),
+			Entry("wildcard map", messagev1ddbpath.Kitchen(), "19.*.16.1", ``),
+			Entry("wildcard list", messagev1ddbpath.Kitchen(), "17[*].1", ``),
+			Entry("wildcard basic list", messagev1ddbpath.Kitchen(), "18.*", ``),
+			Entry("wildcard message", messagev1ddbpath.Kitchen(), "16.*", `field selecting '\*' not allowed on Single<messagev1ddbpath.KitchenPath>`),
+			Entry("wildcard basic", messagev1ddbpath.Kitchen(), "1[*]", `field selecting '\*' not allowed on Single`),
 			// any message
 			Entry("any message", messagev1ddbpath.Kitchen(), "22[999].1.1", ``),
 			Entry("any message", messagev1ddbpath.Kitchen(), "22.999.a[1]", ``),
@@ -123,6 +152,78 @@ var _ = Describe("validate", func() {
 
 })
 
+var _ = Describe("introspection", func() {
+	reg := ddbpath.DefaultRegistry()
+
+	It("should enumerate registered types", func() {
+		typs := reg.Types()
+		Expect(typs).To(ContainElements(
+			reflect.TypeOf(messagev1ddbpath.Car()),
+			reflect.TypeOf(messagev1ddbpath.Kitchen()),
+			reflect.TypeOf(ddbpath.ValuePath{})))
+
+		fields, ok := reg.FieldsOfType(typs[0])
+		Expect(ok).To(BeTrue())
+		Expect(fields).ToNot(BeNil())
+	})
+
+	It("should walk the field tree", func() {
+		var paths []string
+		Expect(reg.Walk(messagev1ddbpath.Car(), func(p []ddbpath.PathElement, fi ddbpath.FieldInfo) error {
+			paths = append(paths, ddbpath.FormatPath(p)+":"+string(fi.AttributeType))
+			return nil
+		})).To(Succeed())
+		Expect(paths).To(Equal([]string{".1:M", ".1.1:S", ".1.2:N", ".2:S", ".ws:N"}))
+	})
+
+	It("should walk into list elements, but not recursive messages", func() {
+		var paths []string
+		Expect(reg.Walk(messagev1ddbpath.Kitchen(), func(p []ddbpath.PathElement, fi ddbpath.FieldInfo) error {
+			paths = append(paths, ddbpath.FormatPath(p))
+			return nil
+		})).To(Succeed())
+		Expect(paths).To(ContainElements(".16", ".19", ".19.*.1", ".21.1"))
+		Expect(paths).ToNot(ContainElement(".16.1"))
+	})
+
+	It("should stop walking on error", func() {
+		Expect(reg.Walk(messagev1ddbpath.Car(), func(p []ddbpath.PathElement, fi ddbpath.FieldInfo) error {
+			return fmt.Errorf("stop")
+		})).To(MatchError("stop"))
+	})
+
+	It("should fail to walk unregistered", func() {
+		Expect(reg.Walk(expression.NameBuilder{}, nil)).To(MatchError(MatchRegexp(`type not registered`)))
+	})
+
+	It("should export a schema", func() {
+		doc, err := reg.Schema(messagev1ddbpath.Car())
+		Expect(err).ToNot(HaveOccurred())
+
+		pkg := reflect.TypeOf(messagev1ddbpath.Car()).PkgPath()
+		Expect(doc.Ref).To(Equal("#/$defs/" + pkg + ".CarPath"))
+		Expect(doc.Defs).To(HaveLen(2))
+		Expect(doc.Defs[pkg+".CarPath"].Attributes).To(Equal(map[string]ddbpath.AttributeSchema{
+			"1":  {Kind: "Single", AttributeType: "M", Message: pkg + ".EnginePath", Ref: "#/$defs/" + pkg + ".EnginePath"},
+			"2":  {Kind: "Single", AttributeType: "S"},
+			"ws": {Kind: "Single", AttributeType: "N"},
+		}))
+
+		data, err := json.Marshal(doc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`"attributeType":"M"`))
+	})
+
+	It("should export a schema of recursive messages", func() {
+		doc, err := reg.Schema(messagev1ddbpath.Kitchen())
+		Expect(err).ToNot(HaveOccurred())
+
+		pkg := reflect.TypeOf(messagev1ddbpath.Kitchen()).PkgPath()
+		Expect(doc.Defs[pkg+".KitchenPath"].Attributes["16"].Ref).To(Equal(doc.Ref))
+		Expect(doc.Defs).To(HaveKey(reflect.TypeOf(ddbpath.ValuePath{}).PkgPath() + ".ValuePath"))
+	})
+})
+
 func BenchmarkValidate(b *testing.B) {
 	reg := ddbpath.NewRegistry()
 	reg.Register(messagev1ddbpath.Kitchen(), map[string]ddbpath.FieldInfo{
@@ -0,0 +1,149 @@
+package ddbpath
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+)
+
+// Types returns the registered name building types, sorted by their name
+func (r Registry) Types() []reflect.Type {
+	typs := make([]reflect.Type, 0, len(r.infos))
+	for typ := range r.infos {
+		typs = append(typs, typ)
+	}
+
+	sort.Slice(typs, func(i, j int) bool { return typeName(typs[i]) < typeName(typs[j]) })
+	return typs
+}
+
+// FieldsOfType returns field information of a registered name building type
+func (r Registry) FieldsOfType(typ reflect.Type) (fi map[string]FieldInfo, ok bool) {
+	return r.fieldsOf(typ)
+}
+
+// WalkFunc is called for every field while walking the field tree. The 'path' holds wildcard elements
+// for the elements of lists and maps and is only valid for the duration of the call.
+type WalkFunc func(path []PathElement, fi FieldInfo) error
+
+// Walk calls 'fn' for every field in the field tree of message name builder 'nb', depth-first and in
+// the order of the attribute names. Fields of a message that is already being walked are not walked
+// again, so recursive messages don't walk forever.
+func (r Registry) Walk(nb NameBuilder, fn WalkFunc) error {
+	return r.walk(reflect.TypeOf(nb), nil, map[reflect.Type]bool{}, fn)
+}
+
+// walk the fields of 'typ' with 'path' leading up to it
+func (r Registry) walk(typ reflect.Type, path []PathElement, walking map[reflect.Type]bool, fn WalkFunc) error {
+	fields, ok := r.fieldsOf(typ)
+	if !ok {
+		return errTypeNotRegistered(typ)
+	}
+
+	walking[typ] = true
+	defer delete(walking, typ)
+
+	for _, name := range sortedFieldNames(fields) {
+		fi := fields[name]
+		fpath := append(path, PathElement{name, -1})
+		if err := fn(fpath, fi); err != nil {
+			return err
+		}
+
+		if fi.Message == nil || walking[fi.Message] {
+			continue
+		}
+
+		if fi.Kind == FieldKindList || fi.Kind == FieldKindMap {
+			fpath = append(fpath, PathElement{"*", Wildcard})
+		}
+
+		if err := r.walk(fi.Message, fpath, walking, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SchemaDocument describes the attributes of a message and the messages it refers to, it marshals to
+// a JSON Schema-like document.
+type SchemaDocument struct {
+	Ref  string                   `json:"$ref"`
+	Defs map[string]MessageSchema `json:"$defs"`
+}
+
+// MessageSchema describes the attributes of a message
+type MessageSchema struct {
+	Attributes map[string]AttributeSchema `json:"attributes"`
+}
+
+// AttributeSchema describes a single attribute
+type AttributeSchema struct {
+	Kind              string                           `json:"kind"`
+	AttributeType     expression.DynamoDBAttributeType `json:"attributeType,omitempty"`
+	ElemAttributeType expression.DynamoDBAttributeType `json:"elemAttributeType,omitempty"`
+	Message           string                           `json:"message,omitempty"`
+	Ref               string                           `json:"$ref,omitempty"`
+	Codec             string                           `json:"codec,omitempty"`
+}
+
+// Schema returns a document that describes the attributes of message name builder 'nb', with a
+// definition for every message it refers to.
+func (r Registry) Schema(nb NameBuilder) (doc SchemaDocument, err error) {
+	typ := reflect.TypeOf(nb)
+	doc.Ref, doc.Defs = schemaRef(typ), map[string]MessageSchema{}
+	if err = r.schemaDefs(typ, doc.Defs); err != nil {
+		return doc, fmt.Errorf("failed to describe '%s': %w", typeName(typ), err)
+	}
+	return doc, nil
+}
+
+// schemaDefs adds the definition of 'typ', and of the messages it refers to, to 'defs'
+func (r Registry) schemaDefs(typ reflect.Type, defs map[string]MessageSchema) error {
+	if _, ok := defs[typeName(typ)]; ok {
+		return nil
+	}
+
+	fields, ok := r.fieldsOf(typ)
+	if !ok {
+		return errTypeNotRegistered(typ)
+	}
+
+	def := MessageSchema{Attributes: make(map[string]AttributeSchema, len(fields))}
+	defs[typeName(typ)] = def
+	for name, fi := range fields {
+		as := AttributeSchema{
+			Kind: fi.Kind.String(), AttributeType: fi.AttributeType, ElemAttributeType: fi.ElemAttributeType, Codec: fi.Codec,
+		}
+		if fi.Message != nil {
+			as.Message, as.Ref = typeName(fi.Message), schemaRef(fi.Message)
+			if err := r.schemaDefs(fi.Message, defs); err != nil {
+				return err
+			}
+		}
+		def.Attributes[name] = as
+	}
+	return nil
+}
+
+// typeName returns a name for 'typ' that is unique across packages
+func typeName(typ reflect.Type) string {
+	return typ.PkgPath() + "." + typ.Name()
+}
+
+// schemaRef returns the reference to the definition of 'typ'
+func schemaRef(typ reflect.Type) string {
+	return "#/$defs/" + typeName(typ)
+}
+
+// sortedFieldNames returns the attribute names of 'fields' in order
+func sortedFieldNames(fields map[string]FieldInfo) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
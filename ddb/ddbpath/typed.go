@@ -0,0 +1,241 @@
+package ddbpath
+
+import (
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// name holds the path to a basic type attribute. It doesn't embed the expression.NameBuilder so
+// conditions that don't apply to the attribute's type are not available on the typed paths.
+type name struct{ nb expression.NameBuilder }
+
+// AppendName appends 'field' to the path
+func (p name) AppendName(field expression.NameBuilder) expression.NameBuilder {
+	return p.nb.AppendName(field)
+}
+
+// NameBuilder returns the untyped path, for projections, updates and conditions that are not typed
+func (p name) NameBuilder() expression.NameBuilder {
+	return p.nb
+}
+
+// BuildOperand implements expression.OperandBuilder so the path can be compared to other attributes
+func (p name) BuildOperand() (expression.Operand, error) {
+	return p.nb.BuildOperand()
+}
+
+// AttributeExists returns a condition that checks if the attribute exists
+func (p name) AttributeExists() expression.ConditionBuilder {
+	return p.nb.AttributeExists()
+}
+
+// AttributeNotExists returns a condition that checks if the attribute does not exist
+func (p name) AttributeNotExists() expression.ConditionBuilder {
+	return p.nb.AttributeNotExists()
+}
+
+// AttributeType returns a condition that checks if the attribute is of type 't'
+func (p name) AttributeType(t expression.DynamoDBAttributeType) expression.ConditionBuilder {
+	return p.nb.AttributeType(t)
+}
+
+// Size returns an operand for the size of the attribute
+func (p name) Size() expression.SizeBuilder {
+	return p.nb.Size()
+}
+
+// Remove returns an update that removes the attribute
+func (p name) Remove() Update {
+	return remove(p.nb)
+}
+
+// ordered provides comparisons for attributes of types that DynamoDB orders
+type ordered[T any] struct{ name }
+
+// Set returns an update that sets the attribute to 'v'
+func (p ordered[T]) Set(v T) Update {
+	return set(p.nb, valueOperand(v))
+}
+
+// SetIfNotExists returns an update that sets the attribute to 'v' if it doesn't exist
+func (p ordered[T]) SetIfNotExists(v T) Update {
+	return setIfNotExists(p.nb, valueOperand(v))
+}
+
+// Equal returns a condition that checks if the attribute equals 'v'
+func (p ordered[T]) Equal(v T) expression.ConditionBuilder {
+	return p.nb.Equal(expression.Value(v))
+}
+
+// NotEqual returns a condition that checks if the attribute does not equal 'v'
+func (p ordered[T]) NotEqual(v T) expression.ConditionBuilder {
+	return p.nb.NotEqual(expression.Value(v))
+}
+
+// LessThan returns a condition that checks if the attribute is less than 'v'
+func (p ordered[T]) LessThan(v T) expression.ConditionBuilder {
+	return p.nb.LessThan(expression.Value(v))
+}
+
+// LessThanEqual returns a condition that checks if the attribute is less than or equal to 'v'
+func (p ordered[T]) LessThanEqual(v T) expression.ConditionBuilder {
+	return p.nb.LessThanEqual(expression.Value(v))
+}
+
+// GreaterThan returns a condition that checks if the attribute is greater than 'v'
+func (p ordered[T]) GreaterThan(v T) expression.ConditionBuilder {
+	return p.nb.GreaterThan(expression.Value(v))
+}
+
+// GreaterThanEqual returns a condition that checks if the attribute is greater than or equal to 'v'
+func (p ordered[T]) GreaterThanEqual(v T) expression.ConditionBuilder {
+	return p.nb.GreaterThanEqual(expression.Value(v))
+}
+
+// Between returns a condition that checks if the attribute is between 'lower' and 'upper', inclusive
+func (p ordered[T]) Between(lower, upper T) expression.ConditionBuilder {
+	return p.nb.Between(expression.Value(lower), expression.Value(upper))
+}
+
+// In returns a condition that checks if the attribute equals any of the values
+func (p ordered[T]) In(v T, vs ...T) expression.ConditionBuilder {
+	return p.nb.In(expression.Value(v), values(vs)...)
+}
+
+// String is the path to a string attribute
+type String struct{ ordered[string] }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p String) WithDynamoNameBuilder(n expression.NameBuilder) String {
+	p.nb = n
+	return p
+}
+
+// BeginsWith returns a condition that checks if the string starts with 'prefix'
+func (p String) BeginsWith(prefix string) expression.ConditionBuilder {
+	return p.nb.BeginsWith(prefix)
+}
+
+// Contains returns a condition that checks if the string contains 'substr'
+func (p String) Contains(substr string) expression.ConditionBuilder {
+	return p.nb.Contains(substr)
+}
+
+// Number is the path to a numeric attribute
+type Number[T NumberValue] struct{ ordered[T] }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p Number[T]) WithDynamoNameBuilder(n expression.NameBuilder) Number[T] {
+	p.nb = n
+	return p
+}
+
+// Add returns an update that adds 'v' to the number, or sets it to 'v' if it doesn't exist
+func (p Number[T]) Add(v T) Update {
+	return add(p.nb, expression.Value(valueOperand(v)))
+}
+
+// Bytes is the path to a binary attribute
+type Bytes struct{ ordered[[]byte] }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p Bytes) WithDynamoNameBuilder(n expression.NameBuilder) Bytes {
+	p.nb = n
+	return p
+}
+
+// BeginsWith returns a condition that checks if the value starts with 'prefix'. The expression package
+// only builds begins_with with a string operand, so like BytesKeyCond.SortKeyBeginsWith it checks for
+// the range from 'prefix' up to 'prefix' padded with 0xFF bytes to the maximum sort key size. Values
+// longer than that size only match if they sort below the padded prefix.
+func (p Bytes) BeginsWith(prefix []byte) expression.ConditionBuilder {
+	if len(prefix) == 0 {
+		return p.nb.AttributeType(expression.Binary)
+	}
+
+	lower, upper := prefixRange(prefix)
+	return p.nb.Between(expression.Value(lower), expression.Value(upper))
+}
+
+// Bool is the path to a boolean attribute
+type Bool struct{ name }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p Bool) WithDynamoNameBuilder(n expression.NameBuilder) Bool {
+	p.nb = n
+	return p
+}
+
+// Equal returns a condition that checks if the attribute equals 'v'
+func (p Bool) Equal(v bool) expression.ConditionBuilder {
+	return p.nb.Equal(expression.Value(v))
+}
+
+// NotEqual returns a condition that checks if the attribute does not equal 'v'
+func (p Bool) NotEqual(v bool) expression.ConditionBuilder {
+	return p.nb.NotEqual(expression.Value(v))
+}
+
+// Set returns an update that sets the attribute to 'v'
+func (p Bool) Set(v bool) Update {
+	return set(p.nb, valueOperand(v))
+}
+
+// SetIfNotExists returns an update that sets the attribute to 'v' if it doesn't exist
+func (p Bool) SetIfNotExists(v bool) Update {
+	return setIfNotExists(p.nb, valueOperand(v))
+}
+
+// Enum is the path to an enum attribute, stored as its number. Generated paths type it with the enum
+// when it is declared in another Go package. Enums of the path's own package are typed as protoreflect.Enum
+// since the message package imports its path package, so the path package cannot import the enum.
+type Enum[T protoreflect.Enum] struct{ name }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p Enum[T]) WithDynamoNameBuilder(n expression.NameBuilder) Enum[T] {
+	p.nb = n
+	return p
+}
+
+// Equal returns a condition that checks if the attribute equals 'v'
+func (p Enum[T]) Equal(v T) expression.ConditionBuilder {
+	return p.nb.Equal(enumValue(v))
+}
+
+// NotEqual returns a condition that checks if the attribute does not equal 'v'
+func (p Enum[T]) NotEqual(v T) expression.ConditionBuilder {
+	return p.nb.NotEqual(enumValue(v))
+}
+
+// In returns a condition that checks if the attribute equals any of the values
+func (p Enum[T]) In(v T, vs ...T) expression.ConditionBuilder {
+	others := make([]expression.OperandBuilder, 0, len(vs))
+	for _, v := range vs {
+		others = append(others, enumValue(v))
+	}
+	return p.nb.In(enumValue(v), others...)
+}
+
+// Set returns an update that sets the attribute to 'v'
+func (p Enum[T]) Set(v T) Update {
+	return set(p.nb, enumValue(v))
+}
+
+// SetIfNotExists returns an update that sets the attribute to 'v' if it doesn't exist
+func (p Enum[T]) SetIfNotExists(v T) Update {
+	return setIfNotExists(p.nb, enumValue(v))
+}
+
+// enumValue returns the operand for the number of enum value 'v'
+func enumValue(v protoreflect.Enum) expression.ValueBuilder {
+	return expression.Value(int32(v.Number()))
+}
+
+// values turns 'vs' into value operands
+func values[T any](vs []T) []expression.OperandBuilder {
+	ops := make([]expression.OperandBuilder, 0, len(vs))
+	for _, v := range vs {
+		ops = append(ops, expression.Value(v))
+	}
+	return ops
+}
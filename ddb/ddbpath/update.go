@@ -0,0 +1,345 @@
+package ddbpath
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb"
+	ddbv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Update is a single update operation on an attribute. Updates on different paths are combined
+// into a single update expression using Updates.
+type Update func(ub expression.UpdateBuilder) expression.UpdateBuilder
+
+// Updates combines update operations into an update builder for the expression builder
+func Updates(us ...Update) expression.UpdateBuilder {
+	var ub expression.UpdateBuilder
+	for _, u := range us {
+		ub = u(ub)
+	}
+	return ub
+}
+
+// set returns an update that sets the attribute at 'nb' to the operand
+func set(nb expression.NameBuilder, op expression.OperandBuilder) Update {
+	return func(ub expression.UpdateBuilder) expression.UpdateBuilder {
+		return ub.Set(nb, op)
+	}
+}
+
+// setIfNotExists returns an update that sets the attribute at 'nb' to the operand, if it doesn't exist
+func setIfNotExists(nb expression.NameBuilder, op expression.OperandBuilder) Update {
+	return set(nb, nb.IfNotExists(op))
+}
+
+// remove returns an update that removes the attribute at 'nb'
+func remove(nb expression.NameBuilder) Update {
+	return func(ub expression.UpdateBuilder) expression.UpdateBuilder {
+		return ub.Remove(nb)
+	}
+}
+
+// add returns an update that adds to the number or set attribute at 'nb'
+func add(nb expression.NameBuilder, v expression.ValueBuilder) Update {
+	return func(ub expression.UpdateBuilder) expression.UpdateBuilder {
+		return ub.Add(nb, v)
+	}
+}
+
+// del returns an update that deletes items from the set attribute at 'nb'
+func del(nb expression.NameBuilder, v expression.ValueBuilder) Update {
+	return func(ub expression.UpdateBuilder) expression.UpdateBuilder {
+		return ub.Delete(nb, v)
+	}
+}
+
+// marshalOperand marshals a value the same way generated code marshals the field when the expression
+// is build. Any marshalling error is returned from the expression's Build.
+type marshalOperand func() (types.AttributeValue, error)
+
+// BuildOperand implements expression.OperandBuilder
+func (o marshalOperand) BuildOperand() (expression.Operand, error) {
+	av, err := o()
+	if err != nil {
+		return expression.Operand{}, fmt.Errorf("failed to marshal operand: %w", err)
+	}
+	return expression.Value(av).BuildOperand()
+}
+
+// MarshalDynamoDBAttributeValue allows the operand to be used as a value for ADD and DELETE updates
+func (o marshalOperand) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return o()
+}
+
+// valueOperand marshals a basic value 'v'
+func valueOperand(v any, os ...ddb.Option) marshalOperand {
+	return func() (types.AttributeValue, error) { return ddb.Marshal(v, os...) }
+}
+
+// setOperand marshals a slice of 'T' as a set, it errors for an empty set
+func setOperand[T ddb.SetItem](s []T) marshalOperand {
+	return func() (types.AttributeValue, error) {
+		if len(s) < 1 {
+			return nil, fmt.Errorf("set is empty, DynamoDB doesn't allow empty sets")
+		}
+		return ddb.MarshalSet(s)
+	}
+}
+
+// listOperand marshals messages as a list, after checking they are of the type with full name 'name'
+// if it is not empty
+func listOperand(name protoreflect.FullName, xs []proto.Message) marshalOperand {
+	return func() (types.AttributeValue, error) {
+		l := &types.AttributeValueMemberL{}
+		for i, x := range xs {
+			if got := x.ProtoReflect().Descriptor().FullName(); name != "" && got != name {
+				return nil, fmt.Errorf("message '%d' must be of type '%s', got: '%s'", i, name, got)
+			}
+
+			av, err := ddb.MarshalMessage(x)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal message '%d': %w", i, err)
+			}
+			l.Value = append(l.Value, av)
+		}
+		return l, nil
+	}
+}
+
+// SetMessage returns an update that sets the message attribute at 'nb' to 'x'. The message must be of
+// the type with full name 'name'.
+func SetMessage(nb expression.NameBuilder, name protoreflect.FullName, x proto.Message) Update {
+	return set(nb, messageValue(name, x))
+}
+
+// SetMessageIfNotExists returns an update that sets the message attribute at 'nb' to 'x' if it doesn't
+// exist. The message must be of the type with full name 'name'.
+func SetMessageIfNotExists(nb expression.NameBuilder, name protoreflect.FullName, x proto.Message) Update {
+	return setIfNotExists(nb, messageValue(name, x))
+}
+
+// Remove returns an update that removes the attribute at 'nb'
+func Remove(nb expression.NameBuilder) Update {
+	return remove(nb)
+}
+
+// messageValue marshals message 'x' after checking it is of the type with full name 'name'
+func messageValue(name protoreflect.FullName, x proto.Message, os ...ddb.Option) marshalOperand {
+	return func() (types.AttributeValue, error) {
+		if got := x.ProtoReflect().Descriptor().FullName(); got != name {
+			return nil, fmt.Errorf("message must be of type '%s', got: '%s'", name, got)
+		}
+		return ddb.MarshalMessage(x, os...)
+	}
+}
+
+// Embedded is the path to an attribute that is stored with an embedded encoding, such as JSON. Its value
+// cannot be selected into or compared, but it can be checked for existence and updated as a whole.
+type Embedded struct {
+	name
+	enc ddbv1.Encoding
+	msg protoreflect.FullName
+}
+
+// NewEmbedded inits the path to an attribute that is stored with embed encoding 'enc'
+func NewEmbedded(nb expression.NameBuilder, enc ddbv1.Encoding) Embedded {
+	return Embedded{name: name{nb}, enc: enc}
+}
+
+// NewEmbeddedMessage inits the path to a message attribute that is stored with embed encoding 'enc'. Values
+// that it is set to must be messages of the type with full name 'msg'.
+func NewEmbeddedMessage(nb expression.NameBuilder, enc ddbv1.Encoding, msg protoreflect.FullName) Embedded {
+	return Embedded{name: name{nb}, enc: enc, msg: msg}
+}
+
+// Set returns an update that sets the attribute to 'v', marshalled with the embed encoding
+func (p Embedded) Set(v any) Update {
+	return set(p.nb, p.operand(v))
+}
+
+// SetIfNotExists returns an update that sets the attribute to 'v' if it doesn't exist
+func (p Embedded) SetIfNotExists(v any) Update {
+	return setIfNotExists(p.nb, p.operand(v))
+}
+
+// operand marshals 'v' with the embed encoding of the attribute
+func (p Embedded) operand(v any) marshalOperand {
+	return func() (types.AttributeValue, error) {
+		if p.msg != "" {
+			x, ok := v.(proto.Message)
+			if !ok {
+				return nil, fmt.Errorf("embedded value must be a message of type '%s', got: %T", p.msg, v)
+			}
+			return messageValue(p.msg, x, ddb.Embed(p.enc))()
+		}
+
+		if x, ok := v.(proto.Message); ok {
+			return ddb.MarshalMessage(x, ddb.Embed(p.enc))
+		}
+
+		// lists and maps of messages are embedded using protojson for each message, which is not
+		// supported for arbitrary values.
+		if rt := reflect.TypeOf(v); rt != nil && (rt.Kind() == reflect.Slice || rt.Kind() == reflect.Map) &&
+			rt.Elem().Implements(reflect.TypeOf((*proto.Message)(nil)).Elem()) {
+			return nil, fmt.Errorf("unsupported embedded value: %T", v)
+		}
+
+		return ddb.Marshal(v, ddb.Embed(p.enc))
+	}
+}
+
+// Coded is the path to an attribute that is stored by a codec registered with ddb.RegisterCodec. The
+// attribute's encoding is up to the codec so it cannot be selected into, but it can be checked for
+// existence and updated as a whole with values of the field's type V.
+type Coded[V any] struct {
+	name
+	codec string
+}
+
+// NewCoded inits the path to an attribute that is stored by the codec registered under 'codec'
+func NewCoded[V any](nb expression.NameBuilder, codec string) Coded[V] {
+	return Coded[V]{name: name{nb}, codec: codec}
+}
+
+// Set returns an update that sets the attribute to 'v', marshalled by the codec
+func (p Coded[V]) Set(v V) Update {
+	return set(p.nb, p.operand(v))
+}
+
+// SetIfNotExists returns an update that sets the attribute to 'v' if it doesn't exist
+func (p Coded[V]) SetIfNotExists(v V) Update {
+	return setIfNotExists(p.nb, p.operand(v))
+}
+
+// operand marshals 'v' with the codec of the attribute
+func (p Coded[V]) operand(v V) marshalOperand {
+	return func() (types.AttributeValue, error) { return ddb.MarshalCodec(p.codec, v) }
+}
+
+// ValueList is a list of basic type values that are typed as V
+type ValueList[T interface {
+	WithDynamoNameBuilder(expression.NameBuilder) T
+}, V any] struct{ ItemList[T] }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p ValueList[T, V]) WithDynamoNameBuilder(n expression.NameBuilder) ValueList[T, V] {
+	p.NameBuilder = n
+	return p
+}
+
+// Set returns an update that sets the list to 'vs'
+func (p ValueList[T, V]) Set(vs []V) Update {
+	return set(p.NameBuilder, valueOperand(vs))
+}
+
+// SetIfNotExists returns an update that sets the list to 'vs' if it doesn't exist
+func (p ValueList[T, V]) SetIfNotExists(vs []V) Update {
+	return setIfNotExists(p.NameBuilder, valueOperand(vs))
+}
+
+// ListAppend returns an update that appends 'vs' to the list
+func (p ValueList[T, V]) ListAppend(vs ...V) Update {
+	return set(p.NameBuilder, p.NameBuilder.ListAppend(valueOperand(vs)))
+}
+
+// StringList is a list of strings, it can be checked for containing a string
+type StringList struct{ ValueList[String, string] }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p StringList) WithDynamoNameBuilder(n expression.NameBuilder) StringList {
+	p.NameBuilder = n
+	return p
+}
+
+// Contains returns a condition that checks if the list contains 'v'
+func (p StringList) Contains(v string) expression.ConditionBuilder {
+	return p.NameBuilder.Contains(v)
+}
+
+// ValueSet is a set of values that are typed as V. Sets are unordered so its members cannot be indexed.
+type ValueSet[V ddb.SetItem] struct{ name }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p ValueSet[V]) WithDynamoNameBuilder(n expression.NameBuilder) ValueSet[V] {
+	p.nb = n
+	return p
+}
+
+// Set returns an update that sets the set to 'vs'
+func (p ValueSet[V]) Set(vs []V) Update {
+	return set(p.nb, setOperand(vs))
+}
+
+// SetIfNotExists returns an update that sets the set to 'vs' if it doesn't exist
+func (p ValueSet[V]) SetIfNotExists(vs []V) Update {
+	return setIfNotExists(p.nb, setOperand(vs))
+}
+
+// Add returns an update that adds 'vs' to the set
+func (p ValueSet[V]) Add(vs ...V) Update {
+	return add(p.nb, expression.Value(setOperand(vs)))
+}
+
+// Delete returns an update that deletes 'vs' from the set
+func (p ValueSet[V]) Delete(vs ...V) Update {
+	return del(p.nb, expression.Value(setOperand(vs)))
+}
+
+// StringSet is a set of strings, it can be checked for containing a string. The expression package
+// only builds contains conditions with a string operand, so other sets don't support it.
+type StringSet struct{ ValueSet[string] }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p StringSet) WithDynamoNameBuilder(n expression.NameBuilder) StringSet {
+	p.nb = n
+	return p
+}
+
+// Contains returns a condition that checks if the set contains 'v'
+func (p StringSet) Contains(v string) expression.ConditionBuilder {
+	return p.nb.Contains(v)
+}
+
+// EnumSet is a set of enum values, stored as a number set. Like Enum, it is typed as protoreflect.Enum
+// for enums of the path's own package.
+type EnumSet[E protoreflect.Enum] struct{ name }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p EnumSet[E]) WithDynamoNameBuilder(n expression.NameBuilder) EnumSet[E] {
+	p.nb = n
+	return p
+}
+
+// Set returns an update that sets the set to 'vs'
+func (p EnumSet[E]) Set(vs []E) Update {
+	return set(p.nb, enumSetOperand(vs))
+}
+
+// SetIfNotExists returns an update that sets the set to 'vs' if it doesn't exist
+func (p EnumSet[E]) SetIfNotExists(vs []E) Update {
+	return setIfNotExists(p.nb, enumSetOperand(vs))
+}
+
+// Add returns an update that adds 'vs' to the set
+func (p EnumSet[E]) Add(vs ...E) Update {
+	return add(p.nb, expression.Value(enumSetOperand(vs)))
+}
+
+// Delete returns an update that deletes 'vs' from the set
+func (p EnumSet[E]) Delete(vs ...E) Update {
+	return del(p.nb, expression.Value(enumSetOperand(vs)))
+}
+
+// enumSetOperand marshals the numbers of enum values 'vs' as a number set
+func enumSetOperand[E protoreflect.Enum](vs []E) marshalOperand {
+	nrs := make([]int32, 0, len(vs))
+	for _, v := range vs {
+		nrs = append(nrs, int32(v.Number()))
+	}
+	return setOperand(nrs)
+}
@@ -2,13 +2,16 @@ package ddbpath
 
 import (
 	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 )
 
 // infoField traverses "into" a field during validation
-func (r Registry) intoField(typ reflect.Type, kind FieldKind) (info FieldInfo, fields map[string]FieldInfo, err error) {
-	info = FieldInfo{Kind: kind, Message: typ}
+func (r Registry) intoField(info FieldInfo) (_ FieldInfo, fields map[string]FieldInfo, err error) {
+	typ := info.Message
 	if typ == nil {
-		return
+		return info, nil, nil
 	}
 
 	fields, ok := r.fieldsOf(info.Message)
@@ -18,9 +21,18 @@ func (r Registry) intoField(typ reflect.Type, kind FieldKind) (info FieldInfo, f
 	return info, fields, nil
 }
 
+// elemInfo returns the info of an element in list or map field 'fi'
+func elemInfo(fi FieldInfo) FieldInfo {
+	info := FieldInfo{Kind: FieldKindSingle, Message: fi.Message, AttributeType: fi.ElemAttributeType}
+	if info.AttributeType == "" && fi.Message != nil {
+		info.AttributeType = messageAttributeType(fi.Message)
+	}
+	return info
+}
+
 // traverse from a message reflected on by 'typ' into a path described by 'els'
 func (r Registry) traverse(typ reflect.Type, els []PathElement) (currInfo FieldInfo, currFields map[string]FieldInfo, err error) {
-	currInfo, currFields, err = r.intoField(typ, FieldKindSingle)
+	currInfo, currFields, err = r.intoField(FieldInfo{Kind: FieldKindSingle, Message: typ})
 	if err != nil {
 		return NoInfo, nil, err
 	}
@@ -31,18 +43,38 @@ func (r Registry) traverse(typ reflect.Type, els []PathElement) (currInfo FieldI
 		field, index = els[i].Field, els[i].Index
 
 		// in case we're inside a any field. or the type itself is a any path type
-		// we allow anything afterwards
-		if currInfo.Message == reflect.TypeOf(ValuePath{}) {
+		// we allow anything afterwards, and it can select a value of any type. Lists and maps of
+		// them first select an element.
+		if currInfo.Kind == FieldKindSingle && isFreeForm(currInfo.Message) {
+			currInfo, currFields = FieldInfo{Kind: FieldKindSingle, Message: currInfo.Message}, nil
 			continue
 		}
 
+		// embedded and codec fields are stored as a single attribute that cannot be selected into
+		if currInfo.IsOpaque() {
+			if index >= 0 {
+				return NoInfo, nil, errIndexNotAllowed(index, currInfo)
+			}
+			return NoInfo, nil, errFieldNotAllowed(field, currInfo)
+		}
+
 		switch {
+		case index == Wildcard: // selecting every value of a list or map
+			switch {
+			case (currInfo.Kind == FieldKindList && !currInfo.Set) || currInfo.Kind == FieldKindMap:
+				currInfo, currFields, err = r.intoField(elemInfo(currInfo))
+				if err != nil {
+					return NoInfo, nil, err
+				}
+			default:
+				return NoInfo, nil, errFieldNotAllowed(field, currInfo)
+			}
 		case index >= 0: // selecting index
-			switch currInfo.Kind {
-			case FieldKindList:
+			switch {
+			case currInfo.Kind == FieldKindList && !currInfo.Set:
 				// in case of a list, it will always become a "basic" types since protobuf doesn't allow
 				// list of lists, or list of maps
-				currInfo, currFields, err = r.intoField(currInfo.Message, FieldKindSingle)
+				currInfo, currFields, err = r.intoField(elemInfo(currInfo))
 				if err != nil {
 					return NoInfo, nil, err
 				}
@@ -58,14 +90,14 @@ func (r Registry) traverse(typ reflect.Type, els []PathElement) (currInfo FieldI
 					return NoInfo, nil, errUnknownField(field, currInfo)
 				}
 
-				currInfo, currFields, err = r.intoField(newField.Message, newField.Kind)
+				currInfo, currFields, err = r.intoField(newField)
 				if err != nil {
 					return NoInfo, nil, err
 				}
 			case currInfo.Kind == FieldKindMap:
 				// in case of a map, either a single message or single basic type. Maps of maps
 				// or map of lists is not supported in protobuf.
-				currInfo, currFields, err = r.intoField(currInfo.Message, FieldKindSingle)
+				currInfo, currFields, err = r.intoField(elemInfo(currInfo))
 				if err != nil {
 					return NoInfo, nil, err
 				}
@@ -78,6 +110,54 @@ func (r Registry) traverse(typ reflect.Type, els []PathElement) (currInfo FieldI
 	return currInfo, currFields, nil
 }
 
+// setElemTypes maps set attribute types to the type of their elements
+var setElemTypes = map[expression.DynamoDBAttributeType]expression.DynamoDBAttributeType{
+	expression.StringSet: expression.String,
+	expression.NumberSet: expression.Number,
+	expression.BinarySet: expression.Binary,
+}
+
+// validateOperand checks that 'av' is of the attribute type of 'fi', or of its elements for sets
+func validateOperand(fi FieldInfo, av types.AttributeValue) error {
+	if fi.AttributeType == "" {
+		return nil // attribute can be of any type
+	}
+
+	typ := attributeType(av)
+	if typ == fi.AttributeType || (fi.Set && typ == setElemTypes[fi.AttributeType]) {
+		return nil
+	}
+	return errOperandMismatch(typ, fi)
+}
+
+// attributeType returns the type of attribute value 'av'
+func attributeType(av types.AttributeValue) expression.DynamoDBAttributeType {
+	switch av.(type) {
+	case *types.AttributeValueMemberS:
+		return expression.String
+	case *types.AttributeValueMemberN:
+		return expression.Number
+	case *types.AttributeValueMemberB:
+		return expression.Binary
+	case *types.AttributeValueMemberBOOL:
+		return expression.Boolean
+	case *types.AttributeValueMemberNULL:
+		return expression.Null
+	case *types.AttributeValueMemberL:
+		return expression.List
+	case *types.AttributeValueMemberM:
+		return expression.Map
+	case *types.AttributeValueMemberSS:
+		return expression.StringSet
+	case *types.AttributeValueMemberNS:
+		return expression.NumberSet
+	case *types.AttributeValueMemberBS:
+		return expression.BinarySet
+	default:
+		return ""
+	}
+}
+
 // validate a single type against registry
 func (r Registry) validate(typ reflect.Type, els []PathElement) (err error) {
 	_, _, err = r.traverse(typ, els)
@@ -1,20 +1,116 @@
 package ddbpath
 
 import (
+	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// WellKnown describes how a well-known message is stored, and the path type that builds paths to it
+type WellKnown struct {
+	Marshal       ddb.WellKnownMarshalFunc         // marshals the message into its attribute
+	Unmarshal     ddb.WellKnownUnmarshalFunc       // unmarshals the message from its attribute
+	Path          NameBuilder                      // path type of fields that hold the message
+	AttributeType expression.DynamoDBAttributeType // type of the stored attribute, or empty if it can be any type
+	Fields        map[string]FieldInfo             // fields that paths can select in the attribute, if any
+}
+
+// wellKnownPaths holds the path types of the well-known messages by full name, and the attribute type
+// and message name of their path types
+var wellKnownPaths = struct {
+	sync.RWMutex
+	types map[protoreflect.FullName]reflect.Type
+	attrs map[reflect.Type]expression.DynamoDBAttributeType
+	names map[reflect.Type]protoreflect.FullName
+}{
+	types: map[protoreflect.FullName]reflect.Type{},
+	attrs: map[reflect.Type]expression.DynamoDBAttributeType{},
+	names: map[reflect.Type]protoreflect.FullName{},
+}
+
+// RegisterWellKnown registers the encoding of messages with full name 'name' with ddb.RegisterWellKnown,
+// and its path type with the default registry. Code is generated with the path type when the plugin's
+// 'well_known_path' option maps the message to it. It panics if either is already registered.
+func RegisterWellKnown(name protoreflect.FullName, wk WellKnown) {
+	ddb.RegisterWellKnown(name, wk.Marshal, wk.Unmarshal)
+	registerWellKnownPath(name, wk.Path, wk.AttributeType, wk.Fields)
+}
+
+// WellKnownPath returns the path type that is registered for well-known message 'name'
+func WellKnownPath(name protoreflect.FullName) (typ reflect.Type, ok bool) {
+	wellKnownPaths.RLock()
+	defer wellKnownPaths.RUnlock()
+	typ, ok = wellKnownPaths.types[name]
+	return
+}
+
+// registerWellKnownPath registers path type 'nb' of well-known message 'name', its encoding is
+// registered with the ddb package.
+func registerWellKnownPath(
+	name protoreflect.FullName, nb NameBuilder, at expression.DynamoDBAttributeType, fields map[string]FieldInfo,
+) {
+	wellKnownPaths.Lock()
+	defer wellKnownPaths.Unlock()
+	if _, ok := wellKnownPaths.types[name]; ok {
+		panic(fmt.Sprintf("ddbpath: well-known '%s' already has a path type", name))
+	}
+
+	if fields == nil {
+		fields = map[string]FieldInfo{}
+	}
+	Register(nb, fields)
+	wellKnownPaths.types[name] = reflect.TypeOf(nb)
+	wellKnownPaths.attrs[reflect.TypeOf(nb)] = at
+	wellKnownPaths.names[reflect.TypeOf(nb)] = name
+}
+
+// messageName returns the full name of the message that paths of type T select, or empty if unknown
+func messageName[T any]() protoreflect.FullName {
+	var v T
+	if mp, ok := any(v).(interface{ DynamoMessageName() protoreflect.FullName }); ok {
+		return mp.DynamoMessageName()
+	}
+
+	wellKnownPaths.RLock()
+	defer wellKnownPaths.RUnlock()
+	return wellKnownPaths.names[reflect.TypeOf(v)]
+}
+
+// messageAttributeType returns the type of the attribute that messages of path type 'typ' are stored as,
+// or an empty string if it can be any type.
+func messageAttributeType(typ reflect.Type) expression.DynamoDBAttributeType {
+	wellKnownPaths.RLock()
+	defer wellKnownPaths.RUnlock()
+	if at, ok := wellKnownPaths.attrs[typ]; ok {
+		return at
+	}
+	return expression.Map // messages with generated code are stored as maps
+}
+
 // register our well-known paths
 func init() {
-	Register(ValuePath{}, map[string]FieldInfo{})
-	Register(AnyPath{}, map[string]FieldInfo{
-		"1": {Kind: FieldKindSingle},
-		"2": {Kind: FieldKindSingle, Message: reflect.TypeOf(ValuePath{})},
+	registerWellKnownPath("google.protobuf.Value", ValuePath{}, "", nil)
+	registerWellKnownPath("google.protobuf.Struct", StructPath{}, expression.Map, nil)
+	registerWellKnownPath("google.protobuf.ListValue", ListValuePath{}, expression.List, nil)
+	registerWellKnownPath("google.protobuf.Any", AnyPath{}, expression.Map, map[string]FieldInfo{
+		"1": {
+			Kind: FieldKindSingle, AttributeType: expression.String,
+			FullName: "google.protobuf.Any.type_url",
+		},
+		"2": {
+			Kind: FieldKindSingle, Message: reflect.TypeOf(ValuePath{}), AttributeType: expression.Binary,
+			FullName: "google.protobuf.Any.value",
+		},
 	})
-	Register(FieldMaskPath{}, map[string]FieldInfo{
-		"1": {Kind: FieldKindList},
+	registerWellKnownPath("google.protobuf.FieldMask", FieldMaskPath{}, expression.Map, map[string]FieldInfo{
+		"1": {
+			Kind: FieldKindList, AttributeType: expression.StringSet, Set: true,
+			FullName: "google.protobuf.FieldMask.paths",
+		},
 	})
 }
 
@@ -47,6 +143,35 @@ func (p ValuePath) WithDynamoNameBuilder(n expression.NameBuilder) ValuePath {
 	return p
 }
 
+// StructPath is registered to support path validation into structpb's struct fields. Like ValuePath it
+// has no fields and accepts any path into it.
+type StructPath struct{ expression.NameBuilder }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p StructPath) WithDynamoNameBuilder(n expression.NameBuilder) StructPath {
+	p.NameBuilder = n
+	return p
+}
+
+// ListValuePath is registered to support path validation into structpb's list value fields. Like
+// ValuePath it has no fields and accepts any path into it.
+type ListValuePath struct{ expression.NameBuilder }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p ListValuePath) WithDynamoNameBuilder(n expression.NameBuilder) ListValuePath {
+	p.NameBuilder = n
+	return p
+}
+
+// isFreeForm returns whether path type 'typ' accepts any path into it, since it holds dynamic values
+func isFreeForm(typ reflect.Type) bool {
+	switch typ {
+	case reflect.TypeOf(ValuePath{}), reflect.TypeOf(StructPath{}), reflect.TypeOf(ListValuePath{}):
+		return true
+	}
+	return false
+}
+
 // FieldMaskPath is registered to support path validation of fieldmask
 type FieldMaskPath struct{ expression.NameBuilder }
 
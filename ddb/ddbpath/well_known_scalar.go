@@ -0,0 +1,353 @@
+package ddbpath
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// messageOperand is an operand that marshals a well-known message the same way ddb.MarshalMessage does
+// for the stored attribute. Any marshalling error is returned when the expression is build.
+type messageOperand struct{ x proto.Message }
+
+// BuildOperand implements expression.OperandBuilder
+func (o messageOperand) BuildOperand() (expression.Operand, error) {
+	av, err := ddb.MarshalMessage(o.x)
+	if err != nil {
+		return expression.Operand{}, fmt.Errorf("failed to marshal operand: %w", err)
+	}
+	return expression.Value(av).BuildOperand()
+}
+
+// SortableTimestampLayout formats timestamps in UTC with a fixed 9-digit fraction, so they order as
+// strings. The operands of the ordering conditions on a TimestampPath are formatted with it.
+const SortableTimestampLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// sortableTimestampOperand is an operand that formats a timestamp with SortableTimestampLayout
+type sortableTimestampOperand struct{ t time.Time }
+
+// BuildOperand implements expression.OperandBuilder
+func (o sortableTimestampOperand) BuildOperand() (expression.Operand, error) {
+	if err := timestamppb.New(o.t).CheckValid(); err != nil {
+		return expression.Operand{}, fmt.Errorf("failed to marshal operand: %w", err)
+	}
+	return expression.Value(o.t.UTC().Format(SortableTimestampLayout)).BuildOperand()
+}
+
+// TimestampPath is the path to a timestamppb field, stored as a RFC 3339 string. The stored encoding has
+// 0, 3, 6 or 9 fractional digits, which doesn't order as a string. Before, After and Between therefore
+// compare against operands formatted with SortableTimestampLayout, and only order correctly when the
+// stored values are written with that same layout (e.g. by a registered well-known encoding).
+type TimestampPath struct{ expression.NameBuilder }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p TimestampPath) WithDynamoNameBuilder(n expression.NameBuilder) TimestampPath {
+	p.NameBuilder = n
+	return p
+}
+
+// Equal returns a condition that checks if the timestamp equals 't'
+func (p TimestampPath) Equal(t time.Time) expression.ConditionBuilder {
+	return p.NameBuilder.Equal(messageOperand{timestamppb.New(t)})
+}
+
+// NotEqual returns a condition that checks if the timestamp does not equal 't'
+func (p TimestampPath) NotEqual(t time.Time) expression.ConditionBuilder {
+	return p.NameBuilder.NotEqual(messageOperand{timestamppb.New(t)})
+}
+
+// Before returns a condition that checks if the timestamp is before 't', see TimestampPath for the
+// encoding that stored values need
+func (p TimestampPath) Before(t time.Time) expression.ConditionBuilder {
+	return p.NameBuilder.LessThan(sortableTimestampOperand{t})
+}
+
+// After returns a condition that checks if the timestamp is after 't', see TimestampPath for the
+// encoding that stored values need
+func (p TimestampPath) After(t time.Time) expression.ConditionBuilder {
+	return p.NameBuilder.GreaterThan(sortableTimestampOperand{t})
+}
+
+// Between returns a condition that checks if the timestamp is between 'lower' and 'upper', inclusive. See
+// TimestampPath for the encoding that stored values need.
+func (p TimestampPath) Between(lower, upper time.Time) expression.ConditionBuilder {
+	return p.NameBuilder.Between(sortableTimestampOperand{lower}, sortableTimestampOperand{upper})
+}
+
+// Set returns an update that sets the value to 't'
+func (p TimestampPath) Set(t time.Time) Update {
+	return set(p.NameBuilder, messageOperand{timestamppb.New(t)})
+}
+
+// SetIfNotExists returns an update that sets the value to 't' if it doesn't exist
+func (p TimestampPath) SetIfNotExists(t time.Time) Update {
+	return setIfNotExists(p.NameBuilder, messageOperand{timestamppb.New(t)})
+}
+
+// Remove returns an update that removes the value
+func (p TimestampPath) Remove() Update {
+	return remove(p.NameBuilder)
+}
+
+// DurationPath is the path to a durationpb field, stored as a string (e.g: "1.5s"). The encoding
+// doesn't order lexicographically so only (in)equality is supported.
+type DurationPath struct{ expression.NameBuilder }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p DurationPath) WithDynamoNameBuilder(n expression.NameBuilder) DurationPath {
+	p.NameBuilder = n
+	return p
+}
+
+// Equal returns a condition that checks if the duration equals 'd'
+func (p DurationPath) Equal(d time.Duration) expression.ConditionBuilder {
+	return p.NameBuilder.Equal(messageOperand{durationpb.New(d)})
+}
+
+// NotEqual returns a condition that checks if the duration does not equal 'd'
+func (p DurationPath) NotEqual(d time.Duration) expression.ConditionBuilder {
+	return p.NameBuilder.NotEqual(messageOperand{durationpb.New(d)})
+}
+
+// Set returns an update that sets the value to 'd'
+func (p DurationPath) Set(d time.Duration) Update {
+	return set(p.NameBuilder, messageOperand{durationpb.New(d)})
+}
+
+// SetIfNotExists returns an update that sets the value to 'd' if it doesn't exist
+func (p DurationPath) SetIfNotExists(d time.Duration) Update {
+	return setIfNotExists(p.NameBuilder, messageOperand{durationpb.New(d)})
+}
+
+// Remove returns an update that removes the value
+func (p DurationPath) Remove() Update {
+	return remove(p.NameBuilder)
+}
+
+// StringValuePath is the path to a wrapperspb.StringValue field
+type StringValuePath struct{ expression.NameBuilder }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p StringValuePath) WithDynamoNameBuilder(n expression.NameBuilder) StringValuePath {
+	p.NameBuilder = n
+	return p
+}
+
+// Equal returns a condition that checks if the value equals 'v'
+func (p StringValuePath) Equal(v string) expression.ConditionBuilder {
+	return p.NameBuilder.Equal(messageOperand{wrapperspb.String(v)})
+}
+
+// NotEqual returns a condition that checks if the value does not equal 'v'
+func (p StringValuePath) NotEqual(v string) expression.ConditionBuilder {
+	return p.NameBuilder.NotEqual(messageOperand{wrapperspb.String(v)})
+}
+
+// BeginsWith returns a condition that checks if the value starts with 'prefix'
+func (p StringValuePath) BeginsWith(prefix string) expression.ConditionBuilder {
+	return p.NameBuilder.BeginsWith(prefix)
+}
+
+// Contains returns a condition that checks if the value contains 'substr'
+func (p StringValuePath) Contains(substr string) expression.ConditionBuilder {
+	return p.NameBuilder.Contains(substr)
+}
+
+// Set returns an update that sets the value to 'v'
+func (p StringValuePath) Set(v string) Update {
+	return set(p.NameBuilder, messageOperand{wrapperspb.String(v)})
+}
+
+// SetIfNotExists returns an update that sets the value to 'v' if it doesn't exist
+func (p StringValuePath) SetIfNotExists(v string) Update {
+	return setIfNotExists(p.NameBuilder, messageOperand{wrapperspb.String(v)})
+}
+
+// Remove returns an update that removes the value
+func (p StringValuePath) Remove() Update {
+	return remove(p.NameBuilder)
+}
+
+// BoolValuePath is the path to a wrapperspb.BoolValue field
+type BoolValuePath struct{ expression.NameBuilder }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p BoolValuePath) WithDynamoNameBuilder(n expression.NameBuilder) BoolValuePath {
+	p.NameBuilder = n
+	return p
+}
+
+// Equal returns a condition that checks if the value equals 'v'
+func (p BoolValuePath) Equal(v bool) expression.ConditionBuilder {
+	return p.NameBuilder.Equal(messageOperand{wrapperspb.Bool(v)})
+}
+
+// Set returns an update that sets the value to 'v'
+func (p BoolValuePath) Set(v bool) Update {
+	return set(p.NameBuilder, messageOperand{wrapperspb.Bool(v)})
+}
+
+// SetIfNotExists returns an update that sets the value to 'v' if it doesn't exist
+func (p BoolValuePath) SetIfNotExists(v bool) Update {
+	return setIfNotExists(p.NameBuilder, messageOperand{wrapperspb.Bool(v)})
+}
+
+// Remove returns an update that removes the value
+func (p BoolValuePath) Remove() Update {
+	return remove(p.NameBuilder)
+}
+
+// BytesValuePath is the path to a wrapperspb.BytesValue field
+type BytesValuePath struct{ expression.NameBuilder }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p BytesValuePath) WithDynamoNameBuilder(n expression.NameBuilder) BytesValuePath {
+	p.NameBuilder = n
+	return p
+}
+
+// Equal returns a condition that checks if the value equals 'v'
+func (p BytesValuePath) Equal(v []byte) expression.ConditionBuilder {
+	return p.NameBuilder.Equal(messageOperand{wrapperspb.Bytes(v)})
+}
+
+// NotEqual returns a condition that checks if the value does not equal 'v'
+func (p BytesValuePath) NotEqual(v []byte) expression.ConditionBuilder {
+	return p.NameBuilder.NotEqual(messageOperand{wrapperspb.Bytes(v)})
+}
+
+// Set returns an update that sets the value to 'v'
+func (p BytesValuePath) Set(v []byte) Update {
+	return set(p.NameBuilder, messageOperand{wrapperspb.Bytes(v)})
+}
+
+// SetIfNotExists returns an update that sets the value to 'v' if it doesn't exist
+func (p BytesValuePath) SetIfNotExists(v []byte) Update {
+	return setIfNotExists(p.NameBuilder, messageOperand{wrapperspb.Bytes(v)})
+}
+
+// Remove returns an update that removes the value
+func (p BytesValuePath) Remove() Update {
+	return remove(p.NameBuilder)
+}
+
+// NumberValue constrains the Go types of the numeric wrapper messages
+type NumberValue interface {
+	float64 | float32 | int32 | int64 | uint32 | uint64
+}
+
+// NumberValuePath is the path to one of the numeric wrapperspb fields
+type NumberValuePath[T NumberValue] struct{ expression.NameBuilder }
+
+// DoubleValuePath is the path to a wrapperspb.DoubleValue field
+type DoubleValuePath = NumberValuePath[float64]
+
+// FloatValuePath is the path to a wrapperspb.FloatValue field
+type FloatValuePath = NumberValuePath[float32]
+
+// Int32ValuePath is the path to a wrapperspb.Int32Value field
+type Int32ValuePath = NumberValuePath[int32]
+
+// Int64ValuePath is the path to a wrapperspb.Int64Value field
+type Int64ValuePath = NumberValuePath[int64]
+
+// UInt32ValuePath is the path to a wrapperspb.UInt32Value field
+type UInt32ValuePath = NumberValuePath[uint32]
+
+// UInt64ValuePath is the path to a wrapperspb.UInt64Value field
+type UInt64ValuePath = NumberValuePath[uint64]
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p NumberValuePath[T]) WithDynamoNameBuilder(n expression.NameBuilder) NumberValuePath[T] {
+	p.NameBuilder = n
+	return p
+}
+
+// Equal returns a condition that checks if the value equals 'v'
+func (p NumberValuePath[T]) Equal(v T) expression.ConditionBuilder {
+	return p.NameBuilder.Equal(numberOperand(v))
+}
+
+// NotEqual returns a condition that checks if the value does not equal 'v'
+func (p NumberValuePath[T]) NotEqual(v T) expression.ConditionBuilder {
+	return p.NameBuilder.NotEqual(numberOperand(v))
+}
+
+// LessThan returns a condition that checks if the value is less than 'v'
+func (p NumberValuePath[T]) LessThan(v T) expression.ConditionBuilder {
+	return p.NameBuilder.LessThan(numberOperand(v))
+}
+
+// LessThanEqual returns a condition that checks if the value is less than or equal to 'v'
+func (p NumberValuePath[T]) LessThanEqual(v T) expression.ConditionBuilder {
+	return p.NameBuilder.LessThanEqual(numberOperand(v))
+}
+
+// GreaterThan returns a condition that checks if the value is greater than 'v'
+func (p NumberValuePath[T]) GreaterThan(v T) expression.ConditionBuilder {
+	return p.NameBuilder.GreaterThan(numberOperand(v))
+}
+
+// GreaterThanEqual returns a condition that checks if the value is greater than or equal to 'v'
+func (p NumberValuePath[T]) GreaterThanEqual(v T) expression.ConditionBuilder {
+	return p.NameBuilder.GreaterThanEqual(numberOperand(v))
+}
+
+// Between returns a condition that checks if the value is between 'lower' and 'upper', inclusive
+func (p NumberValuePath[T]) Between(lower, upper T) expression.ConditionBuilder {
+	return p.NameBuilder.Between(numberOperand(lower), numberOperand(upper))
+}
+
+// Set returns an update that sets the value to 'v'
+func (p NumberValuePath[T]) Set(v T) Update {
+	return set(p.NameBuilder, numberOperand(v))
+}
+
+// SetIfNotExists returns an update that sets the value to 'v' if it doesn't exist
+func (p NumberValuePath[T]) SetIfNotExists(v T) Update {
+	return setIfNotExists(p.NameBuilder, numberOperand(v))
+}
+
+// Remove returns an update that removes the value
+func (p NumberValuePath[T]) Remove() Update {
+	return remove(p.NameBuilder)
+}
+
+// numberOperand wraps 'v' in its wrapper message so it is marshalled as the stored attribute
+func numberOperand[T NumberValue](v T) messageOperand {
+	switch vt := any(v).(type) {
+	case float64:
+		return messageOperand{wrapperspb.Double(vt)}
+	case float32:
+		return messageOperand{wrapperspb.Float(vt)}
+	case int32:
+		return messageOperand{wrapperspb.Int32(vt)}
+	case int64:
+		return messageOperand{wrapperspb.Int64(vt)}
+	case uint32:
+		return messageOperand{wrapperspb.UInt32(vt)}
+	default:
+		return messageOperand{wrapperspb.UInt64(any(v).(uint64))}
+	}
+}
+
+// register the scalar well-known paths, they have no fields to validate
+func init() {
+	registerWellKnownPath("google.protobuf.Timestamp", TimestampPath{}, expression.String, nil)
+	registerWellKnownPath("google.protobuf.Duration", DurationPath{}, expression.String, nil)
+	registerWellKnownPath("google.protobuf.StringValue", StringValuePath{}, expression.String, nil)
+	registerWellKnownPath("google.protobuf.BoolValue", BoolValuePath{}, expression.Boolean, nil)
+	registerWellKnownPath("google.protobuf.BytesValue", BytesValuePath{}, expression.Binary, nil)
+	registerWellKnownPath("google.protobuf.DoubleValue", DoubleValuePath{}, expression.Number, nil)
+	registerWellKnownPath("google.protobuf.FloatValue", FloatValuePath{}, expression.Number, nil)
+	registerWellKnownPath("google.protobuf.Int32Value", Int32ValuePath{}, expression.Number, nil)
+	registerWellKnownPath("google.protobuf.Int64Value", Int64ValuePath{}, expression.Number, nil)
+	registerWellKnownPath("google.protobuf.UInt32Value", UInt32ValuePath{}, expression.Number, nil)
+	registerWellKnownPath("google.protobuf.UInt64Value", UInt64ValuePath{}, expression.Number, nil)
+}
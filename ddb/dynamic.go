@@ -0,0 +1,512 @@
+package ddb
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	ddbv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// MarshalDynamic marshals any protobuf message 'x' into a DynamoDB attribute map by reflecting on its
+// descriptor at runtime. It produces the same item layout as the generated MarshalDynamoItem methods
+// and honors the (ddb.v1.field) options. This allows messages from packages that were not run through
+// the code generator, or dynamicpb messages, to be stored.
+func MarshalDynamic(x proto.Message) (m map[string]types.AttributeValue, err error) {
+	m = make(map[string]types.AttributeValue)
+	xr := x.ProtoReflect()
+	fds := xr.Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		fopts, err := DynamicFieldOptions(fd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read options of field '%s': %w", fd.Name(), err)
+		} else if fopts.GetOmit() {
+			continue // never marshal omitted fields
+		}
+
+		if !dynamicPresent(xr, fd) {
+			continue
+		}
+
+		m[dynamicAttrName(fd, fopts)], err = marshalDynamicField(xr.Get(fd), fd, fopts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field '%s': %w", fd.Name(), err)
+		}
+	}
+	return m, nil
+}
+
+// UnmarshalDynamic unmarshals the attribute map 'm' into protobuf message 'x' by reflecting on its
+// descriptor at runtime. It reads the same item layout as the generated UnmarshalDynamoItem methods.
+func UnmarshalDynamic(m map[string]types.AttributeValue, x proto.Message) (err error) {
+	xr := x.ProtoReflect()
+	fds := xr.Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		fopts, err := DynamicFieldOptions(fd)
+		if err != nil {
+			return fmt.Errorf("failed to read options of field '%s': %w", fd.Name(), err)
+		} else if fopts.GetOmit() {
+			continue // never unmarshal omitted fields
+		}
+
+		av := m[dynamicAttrName(fd, fopts)]
+		if av == nil {
+			continue
+		}
+
+		if err = unmarshalDynamicField(xr, av, fd, fopts); err != nil {
+			return fmt.Errorf("failed to unmarshal field '%s': %w", fd.Name(), err)
+		}
+	}
+	return nil
+}
+
+// DynamicFieldOptions returns the plugin specific options of a field descriptor. It returns nil if the
+// field has no options. If the options were parsed without knowledge of the extension (e.g. from a
+// descriptor set) it will attempt to parse them from the unknown fields, and returns an error if they
+// are malformed.
+func DynamicFieldOptions(fd protoreflect.FieldDescriptor) (*ddbv1.FieldOptions, error) {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil {
+		return nil, nil
+	}
+
+	if proto.HasExtension(opts, ddbv1.E_Field) {
+		fopts, _ := proto.GetExtension(opts, ddbv1.E_Field).(*ddbv1.FieldOptions)
+		return fopts, nil
+	}
+
+	if len(opts.ProtoReflect().GetUnknown()) < 1 {
+		return nil, nil
+	}
+
+	resolved := &descriptorpb.FieldOptions{}
+	if err := (proto.UnmarshalOptions{Resolver: protoregistry.GlobalTypes}).
+		Unmarshal(opts.ProtoReflect().GetUnknown(), resolved); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal unknown options: %w", err)
+	}
+	fopts, _ := proto.GetExtension(resolved, ddbv1.E_Field).(*ddbv1.FieldOptions)
+	return fopts, nil
+}
+
+// DynamicAttrName returns the DynamoDB attribute name of a field, given its descriptor.
+func DynamicAttrName(fd protoreflect.FieldDescriptor) (string, error) {
+	fopts, err := DynamicFieldOptions(fd)
+	if err != nil {
+		return "", err
+	}
+	return dynamicAttrName(fd, fopts), nil
+}
+
+// dynamicAttrName returns the attribute name of a field with options 'fopts'
+func dynamicAttrName(fd protoreflect.FieldDescriptor, fopts *ddbv1.FieldOptions) string {
+	if fopts != nil && fopts.Name != nil {
+		return fopts.GetName() // explicit name option
+	}
+	return strconv.FormatInt(int64(fd.Number()), 10)
+}
+
+// dynamicEmbedOption returns the embed option for a field, the same as the generated code would use.
+func dynamicEmbedOption(fopts *ddbv1.FieldOptions) Option {
+	if fopts.GetEmbed() == ddbv1.Encoding_ENCODING_JSON {
+		return Embed(ddbv1.Encoding_ENCODING_JSON)
+	}
+	return Embed(ddbv1.Encoding_ENCODING_DYNAMO)
+}
+
+// dynamicPresent determines if a field should be included in the attribute map. It mirrors the
+// presence conditions in the generated marshal code.
+func dynamicPresent(xr protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+	switch {
+	case fd.IsList():
+		return xr.Get(fd).List().Len() != 0
+	case fd.IsMap():
+		return xr.Get(fd).Map().Len() != 0
+	case !fd.HasPresence() && (fd.Kind() == protoreflect.FloatKind || fd.Kind() == protoreflect.DoubleKind):
+		return xr.Get(fd).Float() != 0 // like the generated code, -0.0 is not present, while Has reports it
+	default:
+		return xr.Has(fd)
+	}
+}
+
+// marshalDynamicField marshals the value of a single field
+func marshalDynamicField(v protoreflect.Value, fd protoreflect.FieldDescriptor, fopts *ddbv1.FieldOptions) (types.AttributeValue, error) {
+	if codec := fopts.GetCodec(); codec != "" {
+		return marshalDynamicCodec(v, fd, codec)
+	}
+
+	emb := dynamicEmbedOption(fopts)
+	switch {
+	case fd.IsList() && fd.Message() != nil:
+		return marshalDynamicList(v.List(), emb)
+	case fd.IsList() && fopts.GetSet():
+		return marshalDynamicSet(dynamicGoList(v.List(), fd), emb)
+	case fd.IsList():
+		return Marshal(dynamicGoList(v.List(), fd).Interface(), emb)
+	case fd.IsMap() && fd.MapValue().Message() != nil:
+		return marshalDynamicMap(v.Map(), fd, emb)
+	case fd.IsMap():
+		return Marshal(dynamicGoMap(v.Map(), fd).Interface(), emb)
+	case fd.Message() != nil:
+		return MarshalMessage(v.Message().Interface(), emb)
+	default:
+		return Marshal(dynamicGoValue(v, fd).Interface(), emb)
+	}
+}
+
+// unmarshalDynamicField unmarshals a single attribute value 'av' into field 'fd' of 'xr'.
+func unmarshalDynamicField(xr protoreflect.Message, av types.AttributeValue, fd protoreflect.FieldDescriptor, fopts *ddbv1.FieldOptions) error {
+	emb := dynamicEmbedOption(fopts)
+	dec := func(v any) error { return Unmarshal(av, v, emb) }
+
+	// fields with a codec are decoded into the same Go values as the generated code passes to it
+	codec := fopts.GetCodec()
+	if codec != "" {
+		if dynamicElemIsMessage(fd) {
+			return errDynamicCodec(fd, codec)
+		}
+		dec = func(v any) error { return UnmarshalCodec(codec, av, v) }
+	}
+
+	switch {
+	case fd.IsList() && fd.Message() != nil:
+		return unmarshalDynamicList(av, xr.Mutable(fd).List(), emb)
+	case fd.IsList():
+		gl := reflect.New(reflect.SliceOf(dynamicGoType(fd)))
+		if err := dec(gl.Interface()); err != nil {
+			return err
+		}
+
+		l := xr.Mutable(fd).List()
+		for i := 0; i < gl.Elem().Len(); i++ {
+			l.Append(dynamicProtoValue(gl.Elem().Index(i), fd))
+		}
+		return nil
+	case fd.IsMap() && fd.MapValue().Message() != nil:
+		return unmarshalDynamicMap(av, xr.Mutable(fd).Map(), fd, emb)
+	case fd.IsMap():
+		gm := reflect.New(reflect.MapOf(dynamicGoType(fd.MapKey()), dynamicGoType(fd.MapValue())))
+		if err := dec(gm.Interface()); err != nil {
+			return err
+		}
+
+		m := xr.Mutable(fd).Map()
+		for it := gm.Elem().MapRange(); it.Next(); {
+			m.Set(dynamicProtoValue(it.Key(), fd.MapKey()).MapKey(), dynamicProtoValue(it.Value(), fd.MapValue()))
+		}
+		return nil
+	case fd.Message() != nil && codec != "":
+		mv := xr.NewField(fd)
+		if err := dec(mv.Message().Interface()); err != nil {
+			return err
+		}
+		xr.Set(fd, mv)
+		return nil
+	case fd.Message() != nil:
+		mv := xr.NewField(fd)
+		if err := UnmarshalMessage(av, mv.Message().Interface(), emb); err != nil {
+			return err
+		}
+		xr.Set(fd, mv)
+		return nil
+	default:
+		// just like the generated code, a NULL attribute for a field with explicit presence
+		// leaves the field unset.
+		if _, ok := av.(*types.AttributeValueMemberNULL); ok && fd.HasPresence() && codec == "" {
+			return nil
+		}
+
+		gv := reflect.New(dynamicGoType(fd))
+		if err := dec(gv.Interface()); err != nil {
+			return err
+		}
+		xr.Set(fd, dynamicProtoValue(gv.Elem(), fd))
+		return nil
+	}
+}
+
+// marshalDynamicCodec marshals a field with its codec. It passes the same Go values as the generated
+// code, enums are passed as int32 like MarshalCodec does for generated enums.
+func marshalDynamicCodec(v protoreflect.Value, fd protoreflect.FieldDescriptor, codec string) (types.AttributeValue, error) {
+	switch {
+	case dynamicElemIsMessage(fd):
+		return nil, errDynamicCodec(fd, codec)
+	case fd.IsList():
+		return MarshalCodec(codec, dynamicGoList(v.List(), fd).Interface())
+	case fd.IsMap():
+		return MarshalCodec(codec, dynamicGoMap(v.Map(), fd).Interface())
+	case fd.Message() != nil:
+		return MarshalCodec(codec, v.Message().Interface())
+	default:
+		return MarshalCodec(codec, dynamicGoValue(v, fd).Interface())
+	}
+}
+
+// dynamicElemIsMessage returns whether a list or map field holds messages
+func dynamicElemIsMessage(fd protoreflect.FieldDescriptor) bool {
+	return (fd.IsList() && fd.Message() != nil) || (fd.IsMap() && fd.MapValue().Message() != nil)
+}
+
+// errDynamicCodec is returned for codec fields with lists or maps of messages, the Go slices and maps
+// that the generated code passes to the codec cannot be created through reflection.
+func errDynamicCodec(fd protoreflect.FieldDescriptor, codec string) error {
+	return fmt.Errorf("codec '%s' of field '%s' holds messages, which requires generated code", codec, fd.Name())
+}
+
+// marshalDynamicList marshals a list of messages, like MarshalRepeatedMessage
+func marshalDynamicList(l protoreflect.List, emb Option) (types.AttributeValue, error) {
+	switch applyOptions(emb).embedEncoding {
+	case ddbv1.Encoding_ENCODING_JSON:
+		outer := make([]json.RawMessage, l.Len())
+		for i := 0; i < l.Len(); i++ {
+			b, err := protojson.Marshal(l.Get(i).Message().Interface())
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal repeated message '%d': %w", i, err)
+			}
+			outer[i] = b
+		}
+		return jsonMarshal(outer)
+	default:
+		a := &types.AttributeValueMemberL{}
+		for i := 0; i < l.Len(); i++ {
+			v, err := MarshalMessage(l.Get(i).Message().Interface(), emb)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal item '%d' of repeated message field': %w", i, err)
+			}
+			a.Value = append(a.Value, v)
+		}
+		return a, nil
+	}
+}
+
+// unmarshalDynamicList unmarshals a list of messages, like UnmarshalRepeatedMessage
+func unmarshalDynamicList(av types.AttributeValue, l protoreflect.List, emb Option) error {
+	switch applyOptions(emb).embedEncoding {
+	case ddbv1.Encoding_ENCODING_JSON:
+		var outer []json.RawMessage
+		if err := jsonUnmarshal(av, &outer); err != nil {
+			return fmt.Errorf("failed to unmarshal outer slice: %w", err)
+		}
+		for i, b := range outer {
+			mv := l.NewElement()
+			if err := protojson.Unmarshal(b, mv.Message().Interface()); err != nil {
+				return fmt.Errorf("failed to unmarshal message item '%d': %w", i, err)
+			}
+			l.Append(mv)
+		}
+		return nil
+	default:
+		ml, ok := av.(*types.AttributeValueMemberL)
+		if !ok {
+			return fmt.Errorf("failed to unmarshal repeated field: dynamo value is not a list")
+		}
+		for i, v := range ml.Value {
+			mv := l.NewElement()
+			if _, ok := v.(*types.AttributeValueMemberNULL); !ok {
+				if err := UnmarshalMessage(v, mv.Message().Interface(), emb); err != nil {
+					return fmt.Errorf("failed to unmarshal message item '%d' of field: %w", i, err)
+				}
+			}
+			l.Append(mv)
+		}
+		return nil
+	}
+}
+
+// marshalDynamicMap marshals a map of messages, like MarshalMappedMessage
+func marshalDynamicMap(m protoreflect.Map, fd protoreflect.FieldDescriptor, emb Option) (av types.AttributeValue, err error) {
+	opts := applyOptions(emb)
+	outer := make(map[string]json.RawMessage, m.Len())
+	mv := &types.AttributeValueMemberM{Value: make(map[string]types.AttributeValue, m.Len())}
+	m.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		var kv string
+		kv, err = marshalMapKey(dynamicGoValue(k.Value(), fd.MapKey()).Interface())
+		if err != nil {
+			err = fmt.Errorf("failed to marshal map key: %w", err)
+			return false
+		}
+
+		switch opts.embedEncoding {
+		case ddbv1.Encoding_ENCODING_JSON:
+			if outer[kv], err = protojson.Marshal(v.Message().Interface()); err != nil {
+				err = fmt.Errorf("failed to marshal mapped message '%s': %w", kv, err)
+				return false
+			}
+		default:
+			if mv.Value[kv], err = MarshalMessage(v.Message().Interface(), emb); err != nil {
+				err = fmt.Errorf("failed to marshal mapped message: %w", err)
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.embedEncoding == ddbv1.Encoding_ENCODING_JSON {
+		return jsonMarshal(outer)
+	}
+	return mv, nil
+}
+
+// unmarshalDynamicMap unmarshals a map of messages, like UnmarshalMappedMessage
+func unmarshalDynamicMap(av types.AttributeValue, m protoreflect.Map, fd protoreflect.FieldDescriptor, emb Option) error {
+	switch applyOptions(emb).embedEncoding {
+	case ddbv1.Encoding_ENCODING_JSON:
+		var outer map[string]json.RawMessage
+		if err := jsonUnmarshal(av, &outer); err != nil {
+			return fmt.Errorf("failed to unmarshal outer map: %w", err)
+		}
+		for k, b := range outer {
+			kv, err := dynamicMapKey(k, fd.MapKey())
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal map key: %w", err)
+			}
+			mv := m.NewValue()
+			if err := protojson.Unmarshal(b, mv.Message().Interface()); err != nil {
+				return fmt.Errorf("failed to unmarshal mapped message '%s': %w", k, err)
+			}
+			m.Set(kv, mv)
+		}
+		return nil
+	default:
+		mm, ok := av.(*types.AttributeValueMemberM)
+		if !ok {
+			return fmt.Errorf("failed to unmarshal mapped field: no map attribute provided")
+		}
+		for k, v := range mm.Value {
+			kv, err := dynamicMapKey(k, fd.MapKey())
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal map key: %w", err)
+			}
+			mv := m.NewValue()
+			if _, ok := v.(*types.AttributeValueMemberNULL); !ok {
+				if err = UnmarshalMessage(v, mv.Message().Interface(), emb); err != nil {
+					return fmt.Errorf("failed to unmarshal message map value: %w", err)
+				}
+			}
+			m.Set(kv, mv)
+		}
+		return nil
+	}
+}
+
+// marshalDynamicSet marshals a Go slice as a set, with the same element types the generated code uses
+func marshalDynamicSet(gl reflect.Value, emb Option) (types.AttributeValue, error) {
+	switch s := gl.Interface().(type) {
+	case []string:
+		return MarshalSet(s, emb)
+	case [][]byte:
+		return MarshalSet(s, emb)
+	case []int32:
+		return MarshalSet(s, emb)
+	case []int64:
+		return MarshalSet(s, emb)
+	case []uint32:
+		return MarshalSet(s, emb)
+	case []uint64:
+		return MarshalSet(s, emb)
+	case []float32:
+		return MarshalSet(s, emb)
+	case []float64:
+		return MarshalSet(s, emb)
+	default:
+		return nil, fmt.Errorf("unsupported set item encoding: %T", s)
+	}
+}
+
+// dynamicMapKey parses a map key from its attribute name representation
+func dynamicMapKey(s string, fd protoreflect.FieldDescriptor) (k protoreflect.MapKey, err error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s).MapKey(), nil
+	case protoreflect.BoolKind:
+		b, err := BoolMapKey(s)
+		return protoreflect.ValueOfBool(b).MapKey(), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		u, err := UintMapKey[uint32](s)
+		return protoreflect.ValueOfUint32(u).MapKey(), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		u, err := UintMapKey[uint64](s)
+		return protoreflect.ValueOfUint64(u).MapKey(), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		i, err := IntMapKey[int32](s)
+		return protoreflect.ValueOfInt32(i).MapKey(), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		i, err := IntMapKey[int64](s)
+		return protoreflect.ValueOfInt64(i).MapKey(), err
+	default:
+		return k, fmt.Errorf("unsupported map key kind: %s", fd.Kind())
+	}
+}
+
+// dynamicGoType returns the Go type that the generated code uses for a scalar field.
+func dynamicGoType(fd protoreflect.FieldDescriptor) reflect.Type {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return reflect.TypeOf("")
+	case protoreflect.BoolKind:
+		return reflect.TypeOf(false)
+	case protoreflect.BytesKind:
+		return reflect.TypeOf([]byte{})
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return reflect.TypeOf(int64(0))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return reflect.TypeOf(uint64(0))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, protoreflect.EnumKind:
+		return reflect.TypeOf(int32(0))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return reflect.TypeOf(uint32(0))
+	case protoreflect.DoubleKind:
+		return reflect.TypeOf(float64(0))
+	case protoreflect.FloatKind:
+		return reflect.TypeOf(float32(0))
+	default:
+		return reflect.TypeOf((*any)(nil)).Elem()
+	}
+}
+
+// dynamicGoValue turns a scalar protoreflect value into a Go value of the type generated code uses.
+func dynamicGoValue(v protoreflect.Value, fd protoreflect.FieldDescriptor) reflect.Value {
+	if fd.Kind() == protoreflect.EnumKind {
+		return reflect.ValueOf(int32(v.Enum()))
+	}
+	return reflect.ValueOf(v.Interface()).Convert(dynamicGoType(fd))
+}
+
+// dynamicProtoValue turns a Go value, of the type the generated code uses, back into a protoreflect value.
+func dynamicProtoValue(gv reflect.Value, fd protoreflect.FieldDescriptor) protoreflect.Value {
+	if fd.Kind() == protoreflect.EnumKind {
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(gv.Int()))
+	}
+	return protoreflect.ValueOf(gv.Interface())
+}
+
+// dynamicGoList turns a list of scalars into a Go slice of the type generated code uses
+func dynamicGoList(l protoreflect.List, fd protoreflect.FieldDescriptor) reflect.Value {
+	gl := reflect.MakeSlice(reflect.SliceOf(dynamicGoType(fd)), 0, l.Len())
+	for i := 0; i < l.Len(); i++ {
+		gl = reflect.Append(gl, dynamicGoValue(l.Get(i), fd))
+	}
+	return gl
+}
+
+// dynamicGoMap turns a map of scalars into a Go map of the type generated code uses
+func dynamicGoMap(m protoreflect.Map, fd protoreflect.FieldDescriptor) reflect.Value {
+	gm := reflect.MakeMapWithSize(reflect.MapOf(dynamicGoType(fd.MapKey()), dynamicGoType(fd.MapValue())), m.Len())
+	m.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		gm.SetMapIndex(dynamicGoValue(k.Value(), fd.MapKey()), dynamicGoValue(v, fd.MapValue()))
+		return true
+	})
+	return gm
+}
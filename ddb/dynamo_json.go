@@ -0,0 +1,214 @@
+package ddb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// MarshalDynamoJSON marshals 'x' into the DynamoDB JSON format, as used by the AWS CLI and the S3 table
+// export (e.g: {"1":{"S":"foo"}}). It uses the message's MarshalDynamoItem method if it has one, else it
+// falls back to MarshalDynamic.
+func MarshalDynamoJSON(x proto.Message) ([]byte, error) {
+	item, err := MarshalItem(x)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalItemJSON(item)
+}
+
+// UnmarshalDynamoJSON unmarshals DynamoDB JSON 'b' into 'x'. It uses the message's UnmarshalDynamoItem
+// method if it has one, else it falls back to UnmarshalDynamic.
+func UnmarshalDynamoJSON(b []byte, x proto.Message) error {
+	item, err := UnmarshalItemJSON(b)
+	if err != nil {
+		return err
+	}
+	return unmarshalItem(item, x)
+}
+
+// MarshalItemJSON marshals an attribute map into DynamoDB JSON
+func MarshalItemJSON(item map[string]types.AttributeValue) ([]byte, error) {
+	jm, err := attributeMapJSON(item)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jm)
+}
+
+// UnmarshalItemJSON unmarshals DynamoDB JSON into an attribute map
+func UnmarshalItemJSON(b []byte) (map[string]types.AttributeValue, error) {
+	var jm map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jm); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal item json: %w", err)
+	}
+	return jsonAttributeMap(jm)
+}
+
+// unmarshalItem decodes attribute map 'item' into 'x', preferring the generated code.
+func unmarshalItem(item map[string]types.AttributeValue, x proto.Message) error {
+	if mx, ok := x.(interface {
+		UnmarshalDynamoItem(map[string]types.AttributeValue) error
+	}); ok {
+		return mx.UnmarshalDynamoItem(item)
+	}
+	return UnmarshalDynamic(item, x)
+}
+
+// attributeMapJSON turns an attribute map into its JSON representation
+func attributeMapJSON(m map[string]types.AttributeValue) (map[string]any, error) {
+	jm := make(map[string]any, len(m))
+	for k, v := range m {
+		jv, err := attributeJSON(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode attribute '%s': %w", k, err)
+		}
+		jm[k] = jv
+	}
+	return jm, nil
+}
+
+// attributeJSON turns a single attribute value into its JSON representation
+func attributeJSON(av types.AttributeValue) (any, error) {
+	switch avt := av.(type) {
+	case *types.AttributeValueMemberS:
+		return map[string]any{"S": avt.Value}, nil
+	case *types.AttributeValueMemberN:
+		return map[string]any{"N": avt.Value}, nil
+	case *types.AttributeValueMemberB:
+		return map[string]any{"B": base64.StdEncoding.EncodeToString(avt.Value)}, nil
+	case *types.AttributeValueMemberBOOL:
+		return map[string]any{"BOOL": avt.Value}, nil
+	case *types.AttributeValueMemberNULL:
+		return map[string]any{"NULL": true}, nil
+	case *types.AttributeValueMemberSS:
+		return map[string]any{"SS": nonNilStrings(avt.Value)}, nil
+	case *types.AttributeValueMemberNS:
+		return map[string]any{"NS": nonNilStrings(avt.Value)}, nil
+	case *types.AttributeValueMemberBS:
+		bs := make([]string, len(avt.Value))
+		for i, b := range avt.Value {
+			bs[i] = base64.StdEncoding.EncodeToString(b)
+		}
+		return map[string]any{"BS": bs}, nil
+	case *types.AttributeValueMemberL:
+		l := make([]any, len(avt.Value))
+		for i, v := range avt.Value {
+			jv, err := attributeJSON(v)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode list item '%d': %w", i, err)
+			}
+			l[i] = jv
+		}
+		return map[string]any{"L": l}, nil
+	case *types.AttributeValueMemberM:
+		jm, err := attributeMapJSON(avt.Value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"M": jm}, nil
+	default:
+		return nil, fmt.Errorf("unsupported attribute value: %T", av)
+	}
+}
+
+// jsonAttributeMap decodes the JSON representation of an attribute map
+func jsonAttributeMap(jm map[string]json.RawMessage) (map[string]types.AttributeValue, error) {
+	m := make(map[string]types.AttributeValue, len(jm))
+	for k, b := range jm {
+		av, err := jsonAttribute(b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode attribute '%s': %w", k, err)
+		}
+		m[k] = av
+	}
+	return m, nil
+}
+
+// jsonAttribute decodes the JSON representation of a single attribute value
+func jsonAttribute(b json.RawMessage) (av types.AttributeValue, err error) {
+	var typed map[string]json.RawMessage
+	if err = json.Unmarshal(b, &typed); err != nil {
+		return nil, fmt.Errorf("failed to decode typed attribute: %w", err)
+	}
+	if len(typed) != 1 {
+		return nil, fmt.Errorf("expected exactly one type descriptor, got: %d", len(typed))
+	}
+
+	for typ, raw := range typed {
+		switch typ {
+		case "S":
+			v := &types.AttributeValueMemberS{}
+			err = json.Unmarshal(raw, &v.Value)
+			av = v
+		case "N":
+			v := &types.AttributeValueMemberN{}
+			err = json.Unmarshal(raw, &v.Value)
+			av = v
+		case "B":
+			v := &types.AttributeValueMemberB{}
+			err = json.Unmarshal(raw, &v.Value) // stdlib decodes base64 into []byte
+			av = v
+		case "BOOL":
+			v := &types.AttributeValueMemberBOOL{}
+			err = json.Unmarshal(raw, &v.Value)
+			av = v
+		case "NULL":
+			v := &types.AttributeValueMemberNULL{}
+			err = json.Unmarshal(raw, &v.Value)
+			av = v
+		case "SS":
+			v := &types.AttributeValueMemberSS{}
+			err = json.Unmarshal(raw, &v.Value)
+			av = v
+		case "NS":
+			v := &types.AttributeValueMemberNS{}
+			err = json.Unmarshal(raw, &v.Value)
+			av = v
+		case "BS":
+			v := &types.AttributeValueMemberBS{}
+			err = json.Unmarshal(raw, &v.Value)
+			av = v
+		case "L":
+			var items []json.RawMessage
+			if err = json.Unmarshal(raw, &items); err != nil {
+				break
+			}
+			v := &types.AttributeValueMemberL{Value: make([]types.AttributeValue, len(items))}
+			for i, item := range items {
+				if v.Value[i], err = jsonAttribute(item); err != nil {
+					return nil, fmt.Errorf("failed to decode list item '%d': %w", i, err)
+				}
+			}
+			av = v
+		case "M":
+			var items map[string]json.RawMessage
+			if err = json.Unmarshal(raw, &items); err != nil {
+				break
+			}
+			v := &types.AttributeValueMemberM{}
+			if v.Value, err = jsonAttributeMap(items); err != nil {
+				return nil, err
+			}
+			av = v
+		default:
+			return nil, fmt.Errorf("unsupported type descriptor: %s", typ)
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode attribute value: %w", err)
+	}
+	return av, nil
+}
+
+// nonNilStrings makes sure an empty set is encoded as an empty JSON array
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
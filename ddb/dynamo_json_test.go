@@ -0,0 +1,107 @@
+package ddb_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb"
+	messagev1 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestDdb(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ddb")
+}
+
+var _ = DescribeTable("dynamo json", func(item map[string]types.AttributeValue, expJSON string) {
+	b, err := ddb.MarshalItemJSON(item)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(b).To(MatchJSON(expJSON))
+
+	out, err := ddb.UnmarshalItemJSON(b)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(out).To(Equal(item))
+},
+	Entry("empty", map[string]types.AttributeValue{}, `{}`),
+	Entry("scalars", map[string]types.AttributeValue{
+		"s":    &types.AttributeValueMemberS{Value: "foo"},
+		"n":    &types.AttributeValueMemberN{Value: "1.5"},
+		"b":    &types.AttributeValueMemberB{Value: []byte("bar")},
+		"bool": &types.AttributeValueMemberBOOL{Value: true},
+		"null": &types.AttributeValueMemberNULL{Value: true},
+	}, `{"s":{"S":"foo"},"n":{"N":"1.5"},"b":{"B":"YmFy"},"bool":{"BOOL":true},"null":{"NULL":true}}`),
+	Entry("sets", map[string]types.AttributeValue{
+		"ss": &types.AttributeValueMemberSS{Value: []string{"a", "b"}},
+		"ns": &types.AttributeValueMemberNS{Value: []string{"1", "2"}},
+		"bs": &types.AttributeValueMemberBS{Value: [][]byte{[]byte("a")}},
+	}, `{"ss":{"SS":["a","b"]},"ns":{"NS":["1","2"]},"bs":{"BS":["YQ=="]}}`),
+	Entry("composites", map[string]types.AttributeValue{
+		"l": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+			&types.AttributeValueMemberS{Value: "a"},
+			&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+				"x": &types.AttributeValueMemberN{Value: "1"},
+			}},
+		}},
+	}, `{"l":{"L":[{"S":"a"},{"M":{"x":{"N":"1"}}}]}}`),
+)
+
+var _ = DescribeTable("dynamo json errors", func(in string, expErr string) {
+	_, err := ddb.UnmarshalItemJSON([]byte(in))
+	Expect(err).To(MatchError(MatchRegexp(expErr)))
+},
+	Entry("not an object", `[]`, `failed to unmarshal item json`),
+	Entry("unknown type", `{"a":{"X":"1"}}`, `unsupported type descriptor: X`),
+	Entry("multiple types", `{"a":{"S":"1","N":"1"}}`, `expected exactly one type descriptor, got: 2`),
+	Entry("invalid value", `{"a":{"S":1}}`, `failed to decode attribute value`),
+)
+
+var _ = Describe("messages", func() {
+	It("should round-trip a message", func() {
+		in := &messagev1.Kitchen{Brand: "Siemens", NumSmallKnifes: 4, Timer: durationpb.New(10), StringSet: []string{"a"}}
+		b, err := ddb.MarshalDynamoJSON(in)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(b).To(MatchJSON(`{"1":{"S":"Siemens"},"4":{"N":"4"},"17":{"S":"0.000000010s"},"28":{"SS":["a"]}}`))
+
+		var out messagev1.Kitchen
+		Expect(ddb.UnmarshalDynamoJSON(b, &out)).To(Succeed())
+		Expect(proto.Equal(in, &out)).To(BeTrue())
+	})
+
+	It("should read an export and write it back", func() {
+		export := `{"Item":{"1":{"S":"Siemens"},"3":{"B":"AQ=="}}}
+{"Item":{"1":{"S":"Bosch"},"20":{"L":[{"S":"a"}]}}}
+`
+		rd := ddb.NewExportReader[messagev1.Kitchen](strings.NewReader(export))
+		k1, err := rd.Next()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(proto.Equal(k1, &messagev1.Kitchen{Brand: "Siemens", QrCode: []byte{0x01}})).To(BeTrue())
+		k2, err := rd.Next()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(proto.Equal(k2, &messagev1.Kitchen{Brand: "Bosch", OtherBrands: []string{"a"}})).To(BeTrue())
+		_, err = rd.Next()
+		Expect(err).To(Equal(io.EOF))
+
+		buf := bytes.NewBuffer(nil)
+		wr := ddb.NewExportWriter(buf)
+		Expect(wr.Write(k1)).To(Succeed())
+		Expect(wr.Write(k2)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		exp := strings.Split(strings.TrimSpace(export), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(MatchJSON(exp[0]))
+		Expect(lines[1]).To(MatchJSON(exp[1]))
+	})
+
+	It("should error on lines without item", func() {
+		rd := ddb.NewExportReader[messagev1.Kitchen](strings.NewReader(`{"Foo":{}}`))
+		_, err := rd.Next()
+		Expect(err).To(MatchError(MatchRegexp(`no 'Item' field`)))
+	})
+})
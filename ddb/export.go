@@ -0,0 +1,74 @@
+package ddb
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// exportLine is a single line of the line-delimited DynamoDB JSON export format
+type exportLine struct {
+	Item json.RawMessage `json:"Item"`
+}
+
+// ExportReader reads typed messages from the line-delimited DynamoDB JSON format that is produced
+// by the S3 table export: one {"Item": {...}} object per line.
+type ExportReader[T any, TP ProtoMessage[T]] struct {
+	dec *json.Decoder
+}
+
+// NewExportReader inits a reader that decodes messages of type 'T' from 'r'.
+func NewExportReader[T any, TP ProtoMessage[T]](r io.Reader) *ExportReader[T, TP] {
+	return &ExportReader[T, TP]{dec: json.NewDecoder(r)}
+}
+
+// Next reads the next item and decodes it into a new message. It returns io.EOF when there
+// are no more items to read.
+func (r *ExportReader[T, TP]) Next() (x TP, err error) {
+	var line exportLine
+	if err = r.dec.Decode(&line); err == io.EOF {
+		return nil, io.EOF
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to decode export line: %w", err)
+	}
+
+	if line.Item == nil {
+		return nil, fmt.Errorf("export line has no 'Item' field")
+	}
+
+	x = new(T)
+	if err = UnmarshalDynamoJSON(line.Item, x); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal item: %w", err)
+	}
+	return x, nil
+}
+
+// ExportWriter writes messages in the line-delimited DynamoDB JSON export format.
+type ExportWriter struct {
+	w io.Writer
+}
+
+// NewExportWriter inits a writer that writes items to 'w'.
+func NewExportWriter(w io.Writer) *ExportWriter {
+	return &ExportWriter{w: w}
+}
+
+// Write marshals 'x' and writes it as a single {"Item": {...}} line.
+func (w *ExportWriter) Write(x proto.Message) error {
+	item, err := MarshalDynamoJSON(x)
+	if err != nil {
+		return fmt.Errorf("failed to marshal item: %w", err)
+	}
+
+	b, err := json.Marshal(exportLine{Item: item})
+	if err != nil {
+		return fmt.Errorf("failed to marshal export line: %w", err)
+	}
+
+	if _, err = w.w.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("failed to write export line: %w", err)
+	}
+	return nil
+}
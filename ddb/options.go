@@ -5,6 +5,8 @@ import ddbv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
 // opts holds the options
 type opts struct {
 	embedEncoding ddbv1.Encoding
+	sizeLimit     int
+	sortSets      bool
 }
 
 // applyOptions merges the options together into a single struct
@@ -26,3 +28,19 @@ func Embed(v ddbv1.Encoding) Option {
 		o.embedEncoding = v
 	}
 }
+
+// SizeLimit option will cause item marshalling to fail with ErrItemTooLarge when the item's size
+// exceeds 'n' bytes. Use MaxItemSize to catch items that DynamoDB would reject.
+func SizeLimit(n int) Option {
+	return func(o *opts) {
+		o.sizeLimit = n
+	}
+}
+
+// SortSets option will sort the members of sets when unmarshalling. DynamoDB returns set members in
+// any order, sorting them makes unmarshalled messages comparable.
+func SortSets() Option {
+	return func(o *opts) {
+		o.sortSets = true
+	}
+}
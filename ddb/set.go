@@ -1,31 +1,43 @@
 package ddb
 
 import (
+	"bytes"
 	"fmt"
+	"math/big"
+	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	ddbv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
 )
 
-// MarshalSet will marshal a slice of 'T' to a dynamo set.
-func MarshalSet[T ~uint64 | ~uint32 | ~int32 | ~int64 | string | []byte](s []T, os ...Option) (types.AttributeValue, error) {
+// SetItem constrains the types that can be marshalled as items of a dynamo set. Enums have an int32
+// underlying type so they are stored as a number set.
+type SetItem interface {
+	~uint64 | ~uint32 | ~int32 | ~int64 | ~float32 | ~float64 | string | []byte
+}
+
+// MarshalSet will marshal a slice of 'T' to a dynamo set. DynamoDB rejects duplicate members so they
+// are removed, and members are sorted so the same set always marshals the same way: strings and bytes
+// in lexical order, numbers in numeric order. It returns nil for an empty set, since DynamoDB rejects
+// those as well, so the caller can omit the attribute.
+func MarshalSet[T SetItem](s []T, os ...Option) (types.AttributeValue, error) {
 	opts := applyOptions(os...)
 	switch opts.embedEncoding {
 	case ddbv1.Encoding_ENCODING_JSON:
 		return jsonMarshal(s)
 	case ddbv1.Encoding_ENCODING_DYNAMO:
+		if len(s) < 1 {
+			return nil, nil
+		}
+
 		switch st := any(s).(type) {
 		case []string:
-			a := &types.AttributeValueMemberSS{}
-			a.Value = append(a.Value, st...)
-			return a, nil
+			return &types.AttributeValueMemberSS{Value: sortStrings(append([]string{}, st...))}, nil
 		case [][]byte:
-			a := &types.AttributeValueMemberBS{}
-			a.Value = append(a.Value, st...)
-			return a, nil
-		case []uint64, []uint32, []int32, []int64:
-			a := &types.AttributeValueMemberNS{}
+			return &types.AttributeValueMemberBS{Value: sortBytes(append([][]byte{}, st...))}, nil
+		default: // numbers, including named number types such as enums
+			ns := make([]string, 0, len(s))
 			for _, v := range s {
 				av, err := attributevalue.Marshal(v)
 				if err != nil {
@@ -36,13 +48,124 @@ func MarshalSet[T ~uint64 | ~uint32 | ~int32 | ~int64 | string | []byte](s []T,
 				if !ok {
 					return nil, fmt.Errorf("expected N member encoding for numeric set item, got: %T", av)
 				}
-				a.Value = append(a.Value, avn.Value)
+				ns = append(ns, avn.Value)
+			}
+
+			ns, err := sortNumbers(ns)
+			if err != nil {
+				return nil, err
 			}
-			return a, nil
-		default:
-			return nil, fmt.Errorf("unsupported set item encoding: %T", st)
+			return &types.AttributeValueMemberNS{Value: ns}, nil
 		}
 	default:
 		return nil, errEmbedEncoding()
 	}
 }
+
+// sortStrings sorts 's' in place and removes duplicates
+func sortStrings(s []string) []string {
+	sort.Strings(s)
+	return dedup(s, func(a, b string) bool { return a == b })
+}
+
+// sortBytes sorts 's' in place and removes duplicates
+func sortBytes(s [][]byte) [][]byte {
+	sort.Slice(s, func(i, j int) bool { return bytes.Compare(s[i], s[j]) < 0 })
+	return dedup(s, bytes.Equal)
+}
+
+// sortNumbers sorts the numbers in 's' in place by their value and removes duplicates. Numbers with
+// the same value but a different notation, e.g: "1" and "1.0", are duplicates to DynamoDB.
+func sortNumbers(s []string) ([]string, error) {
+	nrs := make([]*big.Float, len(s))
+	for i, n := range s {
+		f, _, err := big.ParseFloat(n, 10, 256, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse set member '%s' as number: %w", n, err)
+		}
+		nrs[i] = f
+	}
+
+	idx := make([]int, len(s))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	sort.SliceStable(idx, func(i, j int) bool { return nrs[idx[i]].Cmp(nrs[idx[j]]) < 0 })
+	idx = dedup(idx, func(a, b int) bool { return nrs[a].Cmp(nrs[b]) == 0 })
+
+	sorted := make([]string, len(idx))
+	for i, j := range idx {
+		sorted[i] = s[j]
+	}
+	copy(s, sorted)
+	return s[:len(sorted)], nil
+}
+
+// dedup removes consecutive duplicates from sorted slice 's' in place
+func dedup[T any](s []T, eq func(a, b T) bool) []T {
+	if len(s) < 2 {
+		return s
+	}
+
+	n := 1
+	for i := 1; i < len(s); i++ {
+		if !eq(s[n-1], s[i]) {
+			s[n] = s[i]
+			n++
+		}
+	}
+	return s[:n]
+}
+
+// sortSets returns 'av' with the members of every set in it sorted, recursing into maps and lists. The
+// values in 'av' are not changed, containers that hold a set are copied.
+func sortSets(av types.AttributeValue) (types.AttributeValue, error) {
+	switch tav := av.(type) {
+	case *types.AttributeValueMemberSS:
+		return &types.AttributeValueMemberSS{Value: sortStrings(append([]string{}, tav.Value...))}, nil
+	case *types.AttributeValueMemberBS:
+		return &types.AttributeValueMemberBS{Value: sortBytes(append([][]byte{}, tav.Value...))}, nil
+	case *types.AttributeValueMemberNS:
+		ns, err := sortNumbers(append([]string{}, tav.Value...))
+		if err != nil {
+			return nil, err
+		}
+		return &types.AttributeValueMemberNS{Value: ns}, nil
+	case *types.AttributeValueMemberM:
+		m, err := sortItemSets(tav.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &types.AttributeValueMemberM{Value: m}, nil
+	case *types.AttributeValueMemberL:
+		l := &types.AttributeValueMemberL{Value: make([]types.AttributeValue, len(tav.Value))}
+		for i, v := range tav.Value {
+			sv, err := sortSets(v)
+			if err != nil {
+				return nil, fmt.Errorf("failed to sort sets in '%d': %w", i, err)
+			}
+			l.Value[i] = sv
+		}
+		return l, nil
+	default:
+		return av, nil
+	}
+}
+
+// sortItemSets returns a copy of 'item' with the members of every set in it sorted
+func sortItemSets(item map[string]types.AttributeValue) (map[string]types.AttributeValue, error) {
+	if item == nil {
+		return nil, nil
+	}
+
+	sorted := make(map[string]types.AttributeValue, len(item))
+	for k, v := range item {
+		sv, err := sortSets(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sort sets in '%s': %w", k, err)
+		}
+		sorted[k] = sv
+	}
+	return sorted, nil
+}
@@ -0,0 +1,78 @@
+package ddb_test
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb"
+	ddbv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+	messagev1 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("marshal set", func(marshal func() (types.AttributeValue, error), exp types.AttributeValue) {
+	av, err := marshal()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(av).To(Equal(exp))
+},
+	Entry("strings", func() (types.AttributeValue, error) {
+		return ddb.MarshalSet([]string{"b", "a", "b", "c"})
+	}, &types.AttributeValueMemberSS{Value: []string{"a", "b", "c"}}),
+	Entry("bytes", func() (types.AttributeValue, error) {
+		return ddb.MarshalSet([][]byte{{2}, {1, 2}, {2}})
+	}, &types.AttributeValueMemberBS{Value: [][]byte{{1, 2}, {2}}}),
+	Entry("numbers in numeric order", func() (types.AttributeValue, error) {
+		return ddb.MarshalSet([]int64{10, -2, 9, 10, 100})
+	}, &types.AttributeValueMemberNS{Value: []string{"-2", "9", "10", "100"}}),
+	Entry("floats", func() (types.AttributeValue, error) {
+		return ddb.MarshalSet([]float64{1.5, 0.25, 1.5, -3})
+	}, &types.AttributeValueMemberNS{Value: []string{"-3", "0.25", "1.5"}}),
+	Entry("enums", func() (types.AttributeValue, error) {
+		return ddb.MarshalSet([]messagev1.Dirtyness{
+			messagev1.Dirtyness_DIRTYNESS_CLEAN, messagev1.Dirtyness_DIRTYNESS_UNSPECIFIED,
+			messagev1.Dirtyness_DIRTYNESS_CLEAN,
+		})
+	}, &types.AttributeValueMemberNS{Value: []string{"0", "1"}}),
+	Entry("json embedded keeps order and duplicates", func() (types.AttributeValue, error) {
+		return ddb.MarshalSet([]int64{2, 1, 2}, ddb.Embed(ddbv1.Encoding_ENCODING_JSON))
+	}, &types.AttributeValueMemberS{Value: "[2,1,2]"}),
+)
+
+var _ = Describe("sort sets", func() {
+	It("should marshal an empty set as nil so it can be omitted", func() {
+		av, err := ddb.MarshalSet([]string{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(av).To(BeNil())
+	})
+
+	It("should not sort by default", func() {
+		var out []string
+		Expect(ddb.Unmarshal(&types.AttributeValueMemberSS{Value: []string{"b", "a"}}, &out)).To(Succeed())
+		Expect(out).To(Equal([]string{"b", "a"}))
+	})
+
+	It("should sort numbers by value when unmarshalling", func() {
+		var out []float64
+		Expect(ddb.Unmarshal(&types.AttributeValueMemberNS{Value: []string{"10", "1E1", "2.5", "-1"}}, &out,
+			ddb.SortSets())).To(Succeed())
+		Expect(out).To(Equal([]float64{-1, 2.5, 10}))
+	})
+
+	It("should sort sets in an item without changing it", func() {
+		item := map[string]types.AttributeValue{
+			"28": &types.AttributeValueMemberSS{Value: []string{"b", "a"}},
+			"29": &types.AttributeValueMemberNS{Value: []string{"3", "1", "2"}},
+		}
+
+		var out messagev1.Kitchen
+		Expect(ddb.UnmarshalItem(item, &out, ddb.SortSets())).To(Succeed())
+		Expect(out.StringSet).To(Equal([]string{"a", "b"}))
+		Expect(out.NumberSet).To(Equal([]int64{1, 2, 3}))
+		Expect(item["28"]).To(Equal(&types.AttributeValueMemberSS{Value: []string{"b", "a"}}))
+	})
+
+	It("should error on an invalid number", func() {
+		var out []int64
+		Expect(ddb.Unmarshal(&types.AttributeValueMemberNS{Value: []string{"x"}}, &out, ddb.SortSets())).To(
+			MatchError(ContainSubstring("failed to parse set member 'x' as number")))
+	})
+})
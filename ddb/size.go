@@ -0,0 +1,206 @@
+package ddb
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// MaxItemSize is the maximum size of an item that DynamoDB accepts
+const MaxItemSize = 400 * 1024
+
+// ErrItemTooLarge is returned when a marshalled item exceeds the configured size limit
+var ErrItemTooLarge = errors.New("item too large")
+
+// ItemSize estimates the size of an item as DynamoDB accounts for it: the UTF-8 length of each attribute
+// name plus the size of its value.
+func ItemSize(item map[string]types.AttributeValue) (size int) {
+	for k, v := range item {
+		size += len(k) + AttributeSize(v)
+	}
+	return
+}
+
+// AttributeSize estimates the size of a single attribute value, excluding its name.
+func AttributeSize(av types.AttributeValue) (size int) {
+	switch avt := av.(type) {
+	case *types.AttributeValueMemberS:
+		return len(avt.Value)
+	case *types.AttributeValueMemberN:
+		return numberSize(avt.Value)
+	case *types.AttributeValueMemberB:
+		return len(avt.Value)
+	case *types.AttributeValueMemberBOOL, *types.AttributeValueMemberNULL:
+		return 1
+	case *types.AttributeValueMemberSS:
+		for _, s := range avt.Value {
+			size += len(s)
+		}
+		return size
+	case *types.AttributeValueMemberNS:
+		for _, n := range avt.Value {
+			size += numberSize(n)
+		}
+		return size
+	case *types.AttributeValueMemberBS:
+		for _, b := range avt.Value {
+			size += len(b)
+		}
+		return size
+	case *types.AttributeValueMemberL:
+		// lists and maps have 3 bytes of overhead, plus 1 byte per element
+		size = 3
+		for _, v := range avt.Value {
+			size += 1 + AttributeSize(v)
+		}
+		return size
+	case *types.AttributeValueMemberM:
+		size = 3
+		for k, v := range avt.Value {
+			size += 1 + len(k) + AttributeSize(v)
+		}
+		return size
+	default:
+		return 0
+	}
+}
+
+// numberSize estimates the size of a number: 1 byte per two significant digits, plus 1 byte. Leading
+// and trailing zeros are not significant.
+func numberSize(n string) int {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, strings.SplitN(strings.ToLower(n), "e", 2)[0])
+
+	digits = strings.Trim(digits, "0")
+	return (len(digits)+1)/2 + 1
+}
+
+// ReadCapacityUnits returns the read capacity units that reading an item of 'size' bytes consumes. A strongly
+// consistent read consumes one unit per 4KB, an eventually consistent read half of that.
+func ReadCapacityUnits(size int, consistent bool) float64 {
+	units := float64((size + 4095) / 4096)
+	if units < 1 {
+		units = 1
+	}
+	if !consistent {
+		return units / 2
+	}
+	return units
+}
+
+// WriteCapacityUnits returns the write capacity units that writing an item of 'size' bytes consumes: one
+// unit per 1KB.
+func WriteCapacityUnits(size int) int {
+	if units := (size + 1023) / 1024; units > 1 {
+		return units
+	}
+	return 1
+}
+
+// MarshalItem marshals 'x' into an attribute map. It uses the message's MarshalDynamoItem method if it
+// has one, else it falls back to MarshalDynamic. With the SizeLimit option it errors before the item
+// reaches the SDK call.
+func MarshalItem(x proto.Message, os ...Option) (item map[string]types.AttributeValue, err error) {
+	opts := applyOptions(os...)
+	if mx, ok := x.(interface {
+		MarshalDynamoItem() (map[string]types.AttributeValue, error)
+	}); ok {
+		item, err = mx.MarshalDynamoItem()
+	} else {
+		item, err = MarshalDynamic(x)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.sizeLimit > 0 {
+		if err = checkItemSize(item, opts.sizeLimit); err != nil {
+			return nil, err
+		}
+	}
+	return item, nil
+}
+
+// checkItemSize errors with the largest attribute paths when the item is larger than 'limit'
+func checkItemSize(item map[string]types.AttributeValue, limit int) error {
+	size := ItemSize(item)
+	if size <= limit {
+		return nil
+	}
+
+	type attr struct {
+		path string
+		size int
+	}
+
+	attrs := make([]attr, 0, len(item))
+	for k, v := range item {
+		path, size := largestPath(formatPathField(k), v)
+		attrs = append(attrs, attr{path, size})
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		if attrs[i].size == attrs[j].size {
+			return attrs[i].path < attrs[j].path
+		}
+		return attrs[i].size > attrs[j].size
+	})
+
+	if len(attrs) > 3 {
+		attrs = attrs[:3]
+	}
+
+	var largest []string
+	for _, a := range attrs {
+		largest = append(largest, fmt.Sprintf("%s (%d bytes)", a.path, a.size))
+	}
+	return fmt.Errorf("%w: %d bytes exceeds the limit of %d bytes, largest attributes: %s",
+		ErrItemTooLarge, size, limit, strings.Join(largest, ", "))
+}
+
+// largestPath descends into nested lists and maps as long as a single element accounts for most of
+// the size, so the path points at what makes the attribute large. It returns the path and its size.
+func largestPath(path string, av types.AttributeValue) (string, int) {
+	total := AttributeSize(av)
+	switch avt := av.(type) {
+	case *types.AttributeValueMemberL:
+		for i, v := range avt.Value {
+			if AttributeSize(v)*2 > total {
+				return largestPath(fmt.Sprintf("%s[%d]", path, i), v)
+			}
+		}
+	case *types.AttributeValueMemberM:
+		for k, v := range avt.Value {
+			if AttributeSize(v)*2 > total {
+				return largestPath(path+"."+formatPathField(k), v)
+			}
+		}
+	}
+	return path, total
+}
+
+// formatPathField quotes map key or attribute name 'k' when necessary, the same way as ddbpath.FormatPath
+// does, so the reported path parses back into the same elements.
+func formatPathField(k string) string {
+	if k != "" && k != "*" && !strings.ContainsAny(k, `.[]"\`) {
+		return k
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(k); i++ {
+		if k[i] == '"' || k[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(k[i])
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,83 @@
+package ddb_test
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb"
+	messagev1 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("item size", func(item map[string]types.AttributeValue, exp int) {
+	Expect(ddb.ItemSize(item)).To(Equal(exp))
+},
+	Entry("empty", map[string]types.AttributeValue{}, 0),
+	Entry("string", map[string]types.AttributeValue{"ab": &types.AttributeValueMemberS{Value: "héllo"}}, 2+6),
+	Entry("number", map[string]types.AttributeValue{"a": &types.AttributeValueMemberN{Value: "-00123.4500"}}, 1+4),
+	Entry("number zero", map[string]types.AttributeValue{"a": &types.AttributeValueMemberN{Value: "0"}}, 1+1),
+	Entry("binary", map[string]types.AttributeValue{"a": &types.AttributeValueMemberB{Value: []byte{1, 2}}}, 1+2),
+	Entry("bool and null", map[string]types.AttributeValue{
+		"a": &types.AttributeValueMemberBOOL{Value: true},
+		"b": &types.AttributeValueMemberNULL{Value: true},
+	}, 1+1+1+1),
+	Entry("sets", map[string]types.AttributeValue{
+		"a": &types.AttributeValueMemberSS{Value: []string{"foo", "ba"}},
+		"b": &types.AttributeValueMemberNS{Value: []string{"1", "12345"}},
+		"c": &types.AttributeValueMemberBS{Value: [][]byte{{1}, {2, 3}}},
+	}, 1+5+1+(2+4)+1+3),
+	Entry("empty list", map[string]types.AttributeValue{"a": &types.AttributeValueMemberL{}}, 1+3),
+	Entry("nested", map[string]types.AttributeValue{
+		"a": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+			&types.AttributeValueMemberS{Value: "foo"},
+			&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+				"bar": &types.AttributeValueMemberS{Value: "x"},
+			}},
+		}},
+	}, 1+3+(1+3)+(1+3+(1+3+1))),
+)
+
+var _ = DescribeTable("capacity units", func(size int, expRead, expEventual float64, expWrite int) {
+	Expect(ddb.ReadCapacityUnits(size, true)).To(Equal(expRead))
+	Expect(ddb.ReadCapacityUnits(size, false)).To(Equal(expEventual))
+	Expect(ddb.WriteCapacityUnits(size)).To(Equal(expWrite))
+},
+	Entry("empty", 0, 1.0, 0.5, 1),
+	Entry("1KB", 1024, 1.0, 0.5, 1),
+	Entry("just over 1KB", 1025, 1.0, 0.5, 2),
+	Entry("just over 4KB", 4097, 2.0, 1.0, 5),
+)
+
+var _ = Describe("item size limit", func() {
+	It("should return the size of generated messages", func() {
+		size, err := (&messagev1.Kitchen{Brand: "foo", OtherBrands: []string{"a", "b"}}).DynamoItemSize()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(Equal(1 + 3 + 2 + 3 + 2 + 2))
+	})
+
+	It("should marshal items within the limit", func() {
+		item, err := ddb.MarshalItem(&messagev1.Kitchen{Brand: "foo"}, ddb.SizeLimit(ddb.MaxItemSize))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item).To(HaveKey("1"))
+	})
+
+	It("should error with the largest attributes", func() {
+		_, err := ddb.MarshalItem(&messagev1.Kitchen{
+			Brand:       "foo",
+			OtherBrands: []string{"a", strings.Repeat("x", ddb.MaxItemSize)},
+			Calendar:    map[string]int64{"bar": 1},
+		}, ddb.SizeLimit(ddb.MaxItemSize))
+		Expect(err).To(MatchError(ddb.ErrItemTooLarge))
+		Expect(err).To(MatchError(MatchRegexp(
+			`largest attributes: 20\[1\] \(409600 bytes\), 14 \(9 bytes\), 1 \(3 bytes\)$`)))
+	})
+
+	It("should quote map keys in the path of the largest attributes", func() {
+		_, err := ddb.MarshalItem(&messagev1.FieldPresence{
+			StrMap: map[string]string{"a.b": strings.Repeat("x", ddb.MaxItemSize), `c"d`: "y"},
+		}, ddb.SizeLimit(ddb.MaxItemSize))
+		Expect(err).To(MatchError(ddb.ErrItemTooLarge))
+		Expect(err).To(MatchError(MatchRegexp(`largest attributes: strMap\."a\.b" \(409600 bytes\)$`)))
+	})
+})
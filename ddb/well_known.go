@@ -0,0 +1,287 @@
+package ddb
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// WellKnownMarshalFunc marshals a well-known message into an attribute value
+type WellKnownMarshalFunc func(x proto.Message) (types.AttributeValue, error)
+
+// WellKnownUnmarshalFunc unmarshals an attribute value into a well-known message
+type WellKnownUnmarshalFunc func(av types.AttributeValue, x proto.Message) error
+
+// wellKnown holds the functions that a well-known message is marshalled with
+type wellKnown struct {
+	marshal   WellKnownMarshalFunc
+	unmarshal WellKnownUnmarshalFunc
+}
+
+// wellKnowns holds the registered well-known messages by full name
+var wellKnowns = struct {
+	sync.RWMutex
+	m map[protoreflect.FullName]wellKnown
+}{m: map[protoreflect.FullName]wellKnown{}}
+
+// RegisterWellKnown registers how MarshalMessage and UnmarshalMessage encode messages with full name
+// 'name', such as google.type.Date. The functions take precedence over the message's generated code
+// and receive the concrete Go type that is registered with the global protobuf registry, messages of
+// another type (e.g. dynamicpb) are converted first. It panics if the name is already registered, so
+// it is usually called from an init function.
+func RegisterWellKnown(name protoreflect.FullName, marshal WellKnownMarshalFunc, unmarshal WellKnownUnmarshalFunc) {
+	wellKnowns.Lock()
+	defer wellKnowns.Unlock()
+	if _, ok := wellKnowns.m[name]; ok {
+		panic(fmt.Sprintf("ddb: well-known '%s' is already registered", name))
+	}
+	wellKnowns.m[name] = wellKnown{marshal: marshal, unmarshal: unmarshal}
+}
+
+// lookupWellKnown returns the well-known that is registered under 'name'
+func lookupWellKnown(name protoreflect.FullName) (wk wellKnown, ok bool) {
+	wellKnowns.RLock()
+	defer wellKnowns.RUnlock()
+	wk, ok = wellKnowns.m[name]
+	return
+}
+
+// register the well-known messages of the protobuf module
+func init() {
+	RegisterWellKnown("google.protobuf.Duration", marshalJSONString, unmarshalJSONString)
+	RegisterWellKnown("google.protobuf.Timestamp", marshalJSONString, unmarshalJSONString)
+	RegisterWellKnown("google.protobuf.Any", marshalAny, unmarshalAny)
+	RegisterWellKnown("google.protobuf.FieldMask", marshalFieldMask, unmarshalFieldMask)
+	RegisterWellKnown("google.protobuf.Value", marshalValue, unmarshalValue)
+	RegisterWellKnown("google.protobuf.Struct", marshalStruct, unmarshalStruct)
+	RegisterWellKnown("google.protobuf.ListValue", marshalListValue, unmarshalListValue)
+
+	// wrapper types can just call the sdk (un)marshal on the wrapped value
+	registerWrapper("google.protobuf.StringValue", func(x *wrapperspb.StringValue) *string { return &x.Value })
+	registerWrapper("google.protobuf.BoolValue", func(x *wrapperspb.BoolValue) *bool { return &x.Value })
+	registerWrapper("google.protobuf.BytesValue", func(x *wrapperspb.BytesValue) *[]byte { return &x.Value })
+	registerWrapper("google.protobuf.DoubleValue", func(x *wrapperspb.DoubleValue) *float64 { return &x.Value })
+	registerWrapper("google.protobuf.FloatValue", func(x *wrapperspb.FloatValue) *float32 { return &x.Value })
+	registerWrapper("google.protobuf.Int32Value", func(x *wrapperspb.Int32Value) *int32 { return &x.Value })
+	registerWrapper("google.protobuf.Int64Value", func(x *wrapperspb.Int64Value) *int64 { return &x.Value })
+	registerWrapper("google.protobuf.UInt32Value", func(x *wrapperspb.UInt32Value) *uint32 { return &x.Value })
+	registerWrapper("google.protobuf.UInt64Value", func(x *wrapperspb.UInt64Value) *uint64 { return &x.Value })
+}
+
+// registerWrapper registers a wrapper message that is stored as the value that 'value' points to
+func registerWrapper[T any, X proto.Message](name protoreflect.FullName, value func(x X) *T) {
+	RegisterWellKnown(name, func(x proto.Message) (types.AttributeValue, error) {
+		return attributevalue.Marshal(*value(x.(X)))
+	}, func(av types.AttributeValue, x proto.Message) error {
+		return attributevalue.Unmarshal(av, value(x.(X)))
+	})
+}
+
+// marshalJSONString marshals messages that have a JSON string representation, such as durations and
+// timestamps, as that string.
+func marshalJSONString(x proto.Message) (types.AttributeValue, error) {
+	xjson, err := protojson.Marshal(x)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal duration: %w", err)
+	}
+	xjsons, err := strconv.Unquote(string(xjson))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unquote value: %w", err)
+	}
+	return &types.AttributeValueMemberS{Value: xjsons}, nil
+}
+
+// unmarshalJSONString unmarshals messages that are stored as their JSON string representation
+func unmarshalJSONString(m types.AttributeValue, x proto.Message) error {
+	ms, ok := m.(*types.AttributeValueMemberS)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal duration: no string attribute provided")
+	}
+	return protojson.Unmarshal([]byte(strconv.Quote(ms.Value)), x)
+}
+
+// marshalAny marshals an Any message as a map of its type url and value
+func marshalAny(x proto.Message) (_ types.AttributeValue, err error) {
+	xt := x.(*anypb.Any)
+	mv := &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}}
+	mv.Value["1"], err = attributevalue.Marshal(xt.TypeUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Any's TypeURL field: %w", err)
+	}
+	mv.Value["2"], err = attributevalue.Marshal(xt.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Any's Value field: %w", err)
+	}
+	return mv, nil
+}
+
+// unmarshalAny unmarshals an Any message from a map of its type url and value
+func unmarshalAny(m types.AttributeValue, x proto.Message) (err error) {
+	xt := x.(*anypb.Any)
+	mm, ok := m.(*types.AttributeValueMemberM)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal duration: no map attribute provided")
+	}
+	err = attributevalue.Unmarshal(mm.Value["1"], &xt.TypeUrl)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal Any's TypeURL field: %w", err)
+	}
+	err = attributevalue.Unmarshal(mm.Value["2"], &xt.Value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal Any's Value field: %w", err)
+	}
+	return nil
+}
+
+// marshalFieldMask encodes the fieldmask as a map with a single key. Because if we encode it as a set
+// directly it causes trouble when building paths. As repeated fieldmask fields would require list of list
+// indexing. Which is blocked by: https://github.com/crewlinker/protoc-gen-dynamodb/issues/45
+func marshalFieldMask(x proto.Message) (types.AttributeValue, error) {
+	return &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+		"1": &types.AttributeValueMemberSS{Value: x.(*fieldmaskpb.FieldMask).Paths},
+	}}, nil
+}
+
+// unmarshalFieldMask unmarshals the fieldmask from a map with a single key
+func unmarshalFieldMask(m types.AttributeValue, x proto.Message) error {
+	fmm, ok := m.(*types.AttributeValueMemberM)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal duration: no map attribute provided")
+	}
+
+	ss, ok := fmm.Value["1"].(*types.AttributeValueMemberSS)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal duration: no string set attribute provided")
+	}
+	x.(*fieldmaskpb.FieldMask).Paths = ss.Value
+	return nil
+}
+
+// marshalValue marshals a dynamic value as the attribute of whatever type it holds
+func marshalValue(x proto.Message) (types.AttributeValue, error) {
+	return attributevalue.Marshal(x.(*structpb.Value).AsInterface())
+}
+
+// unmarshalValue unmarshals a dynamic value from an attribute of any type
+func unmarshalValue(m types.AttributeValue, x proto.Message) (err error) {
+	xt := x.(*structpb.Value)
+	switch m.(type) {
+	case *types.AttributeValueMemberL:
+		lv, err := newListValue(m)
+		if err != nil {
+			return err
+		}
+		xt.Kind = &structpb.Value_ListValue{ListValue: lv}
+		return nil
+	case *types.AttributeValueMemberM:
+		sv, err := newStruct(m)
+		if err != nil {
+			return err
+		}
+		xt.Kind = &structpb.Value_StructValue{StructValue: sv}
+		return nil
+	case *types.AttributeValueMemberS:
+		var vx string
+		err = attributevalue.Unmarshal(m, &vx)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal structpb Value field: %w", err)
+		}
+		xt.Kind = &structpb.Value_StringValue{StringValue: vx}
+		return nil
+	case *types.AttributeValueMemberBOOL:
+		var vx bool
+		err = attributevalue.Unmarshal(m, &vx)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal structpb Value field: %w", err)
+		}
+		xt.Kind = &structpb.Value_BoolValue{BoolValue: vx}
+		return nil
+	case *types.AttributeValueMemberN:
+		var vx float64
+		err = attributevalue.Unmarshal(m, &vx)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal structpb Value field: %w", err)
+		}
+		xt.Kind = &structpb.Value_NumberValue{NumberValue: vx}
+		return nil
+	case *types.AttributeValueMemberNULL:
+		xt.Kind = &structpb.Value_NullValue{NullValue: structpb.NullValue_NULL_VALUE}
+		return nil
+	default:
+		return fmt.Errorf("failed to unmarshal struct value: unsupported attribute value")
+	}
+}
+
+// marshalStruct marshals a struct as a map attribute of its values
+func marshalStruct(x proto.Message) (types.AttributeValue, error) {
+	return attributevalue.Marshal(x.(*structpb.Struct).AsMap())
+}
+
+// unmarshalStruct unmarshals a struct from a map attribute
+func unmarshalStruct(m types.AttributeValue, x proto.Message) error {
+	if _, ok := m.(*types.AttributeValueMemberM); !ok {
+		return fmt.Errorf("failed to unmarshal struct: no map attribute provided")
+	}
+
+	sv, err := newStruct(m)
+	if err != nil {
+		return err
+	}
+	x.(*structpb.Struct).Fields = sv.Fields
+	return nil
+}
+
+// marshalListValue marshals a list value as a list attribute of its values
+func marshalListValue(x proto.Message) (types.AttributeValue, error) {
+	return attributevalue.Marshal(x.(*structpb.ListValue).AsSlice())
+}
+
+// unmarshalListValue unmarshals a list value from a list attribute
+func unmarshalListValue(m types.AttributeValue, x proto.Message) error {
+	if _, ok := m.(*types.AttributeValueMemberL); !ok {
+		return fmt.Errorf("failed to unmarshal list value: no list attribute provided")
+	}
+
+	lv, err := newListValue(m)
+	if err != nil {
+		return err
+	}
+	x.(*structpb.ListValue).Values = lv.Values
+	return nil
+}
+
+// newStruct inits a struct from map attribute 'm'
+func newStruct(m types.AttributeValue) (*structpb.Struct, error) {
+	vx := map[string]any{}
+	if err := attributevalue.Unmarshal(m, &vx); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal structpb Value field: %w", err)
+	}
+	sv, err := structpb.NewStruct(vx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init structpb.Value: %w", err)
+	}
+	return sv, nil
+}
+
+// newListValue inits a list value from list attribute 'm'
+func newListValue(m types.AttributeValue) (*structpb.ListValue, error) {
+	vx := []any{}
+	if err := attributevalue.Unmarshal(m, &vx); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal structpb Value field: %w", err)
+	}
+	lv, err := structpb.NewList(vx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init structpb.Value: %w", err)
+	}
+	return lv, nil
+}
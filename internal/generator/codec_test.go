@@ -0,0 +1,208 @@
+package generator_test
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb/ddbpath"
+	messagev1 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1"
+	messagev1ddbpath "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1/ddbpath"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func init() {
+	ddb.RegisterCodec("cents", centsCodec{})
+	ddb.RegisterCodec("brand", brandCodec{})
+	ddb.RegisterCodec("joined", joinedCodec{})
+	ddb.RegisterCodec("decimal", decimalCodec{})
+}
+
+// centsCodec stores euros as a number of cents
+type centsCodec struct{}
+
+func (centsCodec) MarshalAttribute(v any) (types.AttributeValue, error) {
+	f, ok := v.(float64)
+	if !ok {
+		return nil, fmt.Errorf("unsupported value: %T", v)
+	}
+	return &types.AttributeValueMemberN{Value: strconv.FormatInt(int64(math.Round(f*100)), 10)}, nil
+}
+
+func (centsCodec) UnmarshalAttribute(av types.AttributeValue, v any) error {
+	n, ok := av.(*types.AttributeValueMemberN)
+	if !ok {
+		return fmt.Errorf("unsupported attribute: %T", av)
+	}
+	c, err := strconv.ParseInt(n.Value, 10, 64)
+	if err != nil {
+		return err
+	}
+	*(v.(*float64)) = float64(c) / 100
+	return nil
+}
+
+// brandCodec stores an engine as its brand, through reflection so it also handles dynamic messages
+type brandCodec struct{}
+
+func (brandCodec) MarshalAttribute(v any) (types.AttributeValue, error) {
+	xr := v.(proto.Message).ProtoReflect()
+	return &types.AttributeValueMemberS{Value: xr.Get(xr.Descriptor().Fields().ByName("brand")).String()}, nil
+}
+
+func (brandCodec) UnmarshalAttribute(av types.AttributeValue, v any) error {
+	s, ok := av.(*types.AttributeValueMemberS)
+	if !ok {
+		return fmt.Errorf("unsupported attribute: %T", av)
+	}
+	xr := v.(proto.Message).ProtoReflect()
+	xr.Set(xr.Descriptor().Fields().ByName("brand"), protoreflect.ValueOfString(s.Value))
+	return nil
+}
+
+// joinedCodec stores a list of strings as a single comma separated string
+type joinedCodec struct{}
+
+func (joinedCodec) MarshalAttribute(v any) (types.AttributeValue, error) {
+	return &types.AttributeValueMemberS{Value: strings.Join(v.([]string), ",")}, nil
+}
+
+func (joinedCodec) UnmarshalAttribute(av types.AttributeValue, v any) error {
+	s, ok := av.(*types.AttributeValueMemberS)
+	if !ok {
+		return fmt.Errorf("unsupported attribute: %T", av)
+	}
+	*(v.(*[]string)) = strings.Split(s.Value, ",")
+	return nil
+}
+
+// decimalCodec stores enum numbers, which codecs are always passed as int32, as decimal strings
+type decimalCodec struct{}
+
+func (decimalCodec) MarshalAttribute(v any) (types.AttributeValue, error) {
+	switch v := v.(type) {
+	case int32:
+		return &types.AttributeValueMemberS{Value: strconv.Itoa(int(v))}, nil
+	case []int32:
+		l := &types.AttributeValueMemberL{}
+		for _, n := range v {
+			l.Value = append(l.Value, &types.AttributeValueMemberS{Value: strconv.Itoa(int(n))})
+		}
+		return l, nil
+	default:
+		return nil, fmt.Errorf("unsupported value: %T", v)
+	}
+}
+
+func (decimalCodec) UnmarshalAttribute(av types.AttributeValue, v any) error {
+	parse := func(av types.AttributeValue) (int32, error) {
+		s, ok := av.(*types.AttributeValueMemberS)
+		if !ok {
+			return 0, fmt.Errorf("unsupported attribute: %T", av)
+		}
+		n, err := strconv.ParseInt(s.Value, 10, 32)
+		return int32(n), err
+	}
+
+	switch v := v.(type) {
+	case *int32:
+		n, err := parse(av)
+		*v = n
+		return err
+	case *[]int32:
+		for _, eav := range av.(*types.AttributeValueMemberL).Value {
+			n, err := parse(eav)
+			if err != nil {
+				return err
+			}
+			*v = append(*v, n)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported value: %T", v)
+	}
+}
+
+var _ = Describe("codecs", func() {
+	It("should marshal and unmarshal fields with their codec", func() {
+		in := &messagev1.Priced{
+			Price:     12.5,
+			Discount:  proto.Float64(0.1),
+			Engine:    &messagev1.Engine{Brand: "v8"},
+			Tags:      []string{"a", "b"},
+			Deal:      &messagev1.Priced_FixedPrice{FixedPrice: 10},
+			Dirtyness: messagev1.Dirtyness_DIRTYNESS_CLEAN,
+			Stains:    []messagev1.Dirtyness{messagev1.Dirtyness_DIRTYNESS_CLEAN, messagev1.Dirtyness_DIRTYNESS_UNSPECIFIED},
+		}
+
+		item, err := in.MarshalDynamoItem()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item).To(Equal(map[string]types.AttributeValue{
+			"1": &types.AttributeValueMemberN{Value: "1250"},
+			"2": &types.AttributeValueMemberN{Value: "10"},
+			"3": &types.AttributeValueMemberS{Value: "v8"},
+			"4": &types.AttributeValueMemberS{Value: "a,b"},
+			"5": &types.AttributeValueMemberN{Value: "1000"},
+			"7": &types.AttributeValueMemberS{Value: "1"},
+			"8": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+				&types.AttributeValueMemberS{Value: "1"}, &types.AttributeValueMemberS{Value: "0"},
+			}},
+		}))
+
+		var out messagev1.Priced
+		Expect(out.UnmarshalDynamoItem(item)).To(Succeed())
+		ExpectProtoEqual(&out, in)
+	})
+
+	It("should error when the codec is not registered", func() {
+		_, err := ddb.MarshalCodec("unregistered", 1)
+		Expect(err).To(MatchError(ddb.ErrCodecNotRegistered))
+	})
+
+	It("should update with the codec", func() {
+		expr, err := expression.NewBuilder().WithUpdate(ddbpath.Updates(
+			messagev1ddbpath.PricedPath{}.Price().Set(1.25),
+			messagev1ddbpath.PricedPath{}.Deal().SetTradeIn(&messagev1.Engine{Brand: "v6"}),
+			messagev1ddbpath.PricedPath{}.Dirtyness().Set(messagev1.Dirtyness_DIRTYNESS_CLEAN),
+			messagev1ddbpath.PricedPath{}.Stains().SetIfNotExists([]protoreflect.Enum{messagev1.Dirtyness_DIRTYNESS_CLEAN}),
+			messagev1ddbpath.PricedPath{}.Tags().Set([]string{"x", "y"}),
+		)).Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expr.Values()).To(Equal(map[string]types.AttributeValue{
+			":0": &types.AttributeValueMemberN{Value: "125"},
+			":1": &types.AttributeValueMemberS{Value: "v6"},
+			":2": &types.AttributeValueMemberS{Value: "1"},
+			":3": &types.AttributeValueMemberL{Value: []types.AttributeValue{&types.AttributeValueMemberS{Value: "1"}}},
+			":4": &types.AttributeValueMemberS{Value: "x,y"},
+		}))
+	})
+
+	It("should not select into codec fields", func() {
+		Expect(ddbpath.Validate(messagev1ddbpath.PricedPath{}, "3.1")).To(
+			MatchError(MatchRegexp(`field selecting '1' not allowed on Single\(codec brand\)`)))
+		Expect(ddbpath.Validate(messagev1ddbpath.PricedPath{}, "4[0]")).To(
+			MatchError(MatchRegexp(`indexing '0' not allowed on List\(codec joined\)`)))
+	})
+})
+
+// dynamic marshalling passes the same values to codecs as the generated code
+var _ = DescribeTable("dynamic parity codecs", func(in itemMessage) {
+	ExpectDynamicParity(in, dynamicDescriptor(in))
+},
+	Entry("zero", &messagev1.Priced{}),
+	Entry("values", &messagev1.Priced{
+		Price: 1.5, Discount: proto.Float64(0), Engine: &messagev1.Engine{}, Tags: []string{"x"},
+		Deal: &messagev1.Priced_TradeIn{TradeIn: &messagev1.Engine{Brand: "v12"}},
+	}),
+	Entry("oneof price", &messagev1.Priced{Deal: &messagev1.Priced_FixedPrice{}}),
+	Entry("enums", &messagev1.Priced{
+		Dirtyness: messagev1.Dirtyness_DIRTYNESS_CLEAN, Stains: []messagev1.Dirtyness{messagev1.Dirtyness_DIRTYNESS_CLEAN},
+	}),
+)
@@ -0,0 +1,279 @@
+package generator_test
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb"
+	ddbv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+	messagev1 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1"
+	fuzz "github.com/google/gofuzz"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// itemMessage is implemented by generated messages
+type itemMessage interface {
+	proto.Message
+	MarshalDynamoItem() (map[string]types.AttributeValue, error)
+}
+
+// dynamicDescriptor loads the descriptor of 'm' through a descriptor set, so the resulting dynamicpb
+// messages share no Go types with the generated code, not even for the well-knowns.
+func dynamicDescriptor(m proto.Message) protoreflect.MessageDescriptor {
+	GinkgoHelper()
+	fds := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		fds.File = append(fds.File, protodesc.ToFileDescriptorProto(fd))
+	}
+
+	desc := m.ProtoReflect().Descriptor()
+	add(desc.ParentFile())
+
+	files, err := protodesc.NewFiles(fds)
+	Expect(err).ToNot(HaveOccurred())
+	d, err := files.FindDescriptorByName(desc.FullName())
+	Expect(err).ToNot(HaveOccurred())
+	return d.(protoreflect.MessageDescriptor)
+}
+
+// NormalizeSets sorts and deduplicates the set fields of 'x' in place, the way marshalling stores them.
+// Sets with an embed encoding are left alone, they are not marshalled as a DynamoDB set.
+func NormalizeSets(x protoreflect.Message) {
+	x.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fopts, err := ddb.DynamicFieldOptions(fd)
+		Expect(err).ToNot(HaveOccurred())
+		switch {
+		case fd.IsList() && fopts.GetSet() && fopts.GetEmbed() != ddbv1.Encoding_ENCODING_JSON:
+			normalizeSetList(v.List())
+		case fd.IsList() && fd.Message() != nil:
+			for i := 0; i < v.List().Len(); i++ {
+				NormalizeSets(v.List().Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				NormalizeSets(mv.Message())
+				return true
+			})
+		case fd.Message() != nil && !fd.IsMap():
+			NormalizeSets(v.Message())
+		}
+		return true
+	})
+}
+
+// normalizeSetList sorts and deduplicates the values of set list 'l'
+func normalizeSetList(l protoreflect.List) {
+	vals := make([]protoreflect.Value, 0, l.Len())
+	for i := 0; i < l.Len(); i++ {
+		vals = append(vals, l.Get(i))
+	}
+
+	less := func(a, b protoreflect.Value) bool {
+		switch av := a.Interface().(type) {
+		case string:
+			return av < b.String()
+		case []byte:
+			return bytes.Compare(av, b.Bytes()) < 0
+		case protoreflect.EnumNumber:
+			return av < b.Enum()
+		case int32, int64:
+			return a.Int() < b.Int()
+		case uint32, uint64:
+			return a.Uint() < b.Uint()
+		default:
+			return a.Float() < b.Float()
+		}
+	}
+
+	sort.SliceStable(vals, func(i, j int) bool { return less(vals[i], vals[j]) })
+	l.Truncate(0)
+	for i, v := range vals {
+		if i > 0 && !less(vals[i-1], v) {
+			continue // duplicate
+		}
+		l.Append(v)
+	}
+}
+
+// ExpectDynamicParity asserts that the dynamic (un)marshalling behaves exactly like the generated code,
+// both for the generated Go type and for a dynamicpb message.
+func ExpectDynamicParity(in itemMessage, desc protoreflect.MessageDescriptor) {
+	GinkgoHelper()
+	NormalizeSets(in.ProtoReflect())
+	exp, expErr := in.MarshalDynamoItem()
+	act, actErr := ddb.MarshalDynamic(in)
+	if expErr != nil {
+		Expect(actErr).To(HaveOccurred())
+		return
+	}
+	Expect(actErr).ToNot(HaveOccurred())
+	Expect(act).To(Equal(exp))
+
+	out := in.ProtoReflect().New().Interface()
+	Expect(ddb.UnmarshalDynamic(act, out)).To(Succeed())
+	ExpectProtoEqual(out, in)
+
+	b, err := proto.Marshal(in)
+	Expect(err).ToNot(HaveOccurred())
+	dyn := dynamicpb.NewMessage(desc)
+	Expect(proto.Unmarshal(b, dyn)).To(Succeed())
+
+	dact, err := ddb.MarshalDynamic(dyn)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(dact).To(Equal(exp))
+
+	dout := dynamicpb.NewMessage(desc)
+	Expect(ddb.UnmarshalDynamic(dact, dout)).To(Succeed())
+	Expect(proto.Equal(dout, dyn)).To(BeTrue())
+}
+
+var _ = Describe("dynamic marshalling", func() {
+	It("should marshal a message from an unknown package inside a generated message", func() {
+		dyn := dynamicpb.NewMessage(dynamicDescriptor(&messagev1.Engine{}))
+		dyn.Set(dyn.Descriptor().Fields().ByName("brand"), protoreflect.ValueOfString("foo"))
+
+		av, err := ddb.MarshalMessage(dyn)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(av).To(Equal(&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+			"1": &types.AttributeValueMemberS{Value: "foo"},
+		}}))
+
+		out := dynamicpb.NewMessage(dyn.Descriptor())
+		Expect(ddb.UnmarshalMessage(av, out)).To(Succeed())
+		Expect(proto.Equal(out, dyn)).To(BeTrue())
+	})
+
+	It("should honor field options", func() {
+		item, err := ddb.MarshalDynamic(&messagev1.Ignored{Pk: "pk", Visible: "v"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item).To(Equal(map[string]types.AttributeValue{"4": &types.AttributeValueMemberS{Value: "v"}}))
+
+		item, err = ddb.MarshalDynamic(&messagev1.Car{NrOfWheels: 4})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item).To(Equal(map[string]types.AttributeValue{"ws": &types.AttributeValueMemberN{Value: "4"}}))
+	})
+
+	It("should error on malformed field options", func() {
+		// the options hold the extension as unknown bytes, that hold a name that is cut short
+		fdp := protodesc.ToFileDescriptorProto(messagev1.File_example_message_v1_message_proto)
+		for _, m := range fdp.MessageType {
+			if m.GetName() == "Engine" {
+				m.Field[0].Options = &descriptorpb.FieldOptions{}
+				m.Field[0].Options.ProtoReflect().SetUnknown(
+					protowire.AppendBytes(protowire.AppendTag(nil, 1098, protowire.BytesType), []byte{0x0a, 0x05, 'a'}))
+			}
+		}
+
+		fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+		Expect(err).ToNot(HaveOccurred())
+		dyn := dynamicpb.NewMessage(fd.Messages().ByName("Engine"))
+		dyn.Set(dyn.Descriptor().Fields().ByName("brand"), protoreflect.ValueOfString("foo"))
+
+		_, err = ddb.DynamicFieldOptions(dyn.Descriptor().Fields().ByName("brand"))
+		Expect(err).To(MatchError(ContainSubstring("failed to unmarshal unknown options")))
+		_, err = ddb.DynamicAttrName(dyn.Descriptor().Fields().ByName("brand"))
+		Expect(err).To(HaveOccurred())
+		_, err = ddb.MarshalDynamic(dyn)
+		Expect(err).To(MatchError(ContainSubstring("failed to read options of field 'brand'")))
+		err = ddb.UnmarshalDynamic(map[string]types.AttributeValue{}, dyn)
+		Expect(err).To(MatchError(ContainSubstring("failed to read options of field 'brand'")))
+	})
+
+	It("should omit negative zero floats like the generated code", func() {
+		in := &messagev1.Kitchen{PercentBlackTiles: float32(math.Copysign(0, -1)), PercentWhiteTiles: math.Copysign(0, -1)}
+		exp, err := in.MarshalDynamoItem()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exp).ToNot(HaveKey("10"))
+		Expect(exp).ToNot(HaveKey("11"))
+
+		act, err := ddb.MarshalDynamic(in)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(act).To(Equal(exp))
+
+		b, err := proto.Marshal(in)
+		Expect(err).ToNot(HaveOccurred())
+		dyn := dynamicpb.NewMessage(dynamicDescriptor(in))
+		Expect(proto.Unmarshal(b, dyn)).To(Succeed())
+		dact, err := ddb.MarshalDynamic(dyn)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dact).To(Equal(exp))
+	})
+})
+
+// We fuzz the dynamic marshalling to assert it produces the same layout as the generated code
+var _ = DescribeTable("dynamic parity fuzz", func(seed int64, newMsg func() itemMessage) {
+	f := fuzz.NewWithSeed(seed).NilChance(0.5)
+	fmt.Fprintf(GinkgoWriter, "Fuzz Seed: %d", seed)
+	desc := dynamicDescriptor(newMsg())
+	for i := 0; i < 1000; i++ {
+		in := newMsg()
+		f.Funcs(PbDurationFuzz, PbTimestampFuzz, PbValueFuzz, PbStructFuzz, PbListValueFuzz).Fuzz(in)
+		if _, err := in.MarshalDynamoItem(); err != nil && strings.Contains(err.Error(), "map key cannot be empty") {
+			continue // skip, unsupported variant
+		}
+
+		// clone normalizes nil messages in maps and lists, which protoreflect can't represent
+		ExpectDynamicParity(proto.Clone(in).(itemMessage), desc)
+	}
+},
+	Entry("kitchen", time.Now().UnixNano(), func() itemMessage {
+		return &messagev1.Kitchen{}
+	}),
+	Entry("set galore", time.Now().UnixNano(), func() itemMessage {
+		return &messagev1.SetGalore{}
+	}),
+	Entry("map galore", time.Now().UnixNano(), func() itemMessage {
+		return &messagev1.MapGalore{}
+	}),
+	Entry("value galore", time.Now().UnixNano(), func() itemMessage {
+		return &messagev1.ValueGalore{}
+	}),
+	Entry("json fields", time.Now().UnixNano(), func() itemMessage {
+		return &messagev1.JsonFields{}
+	}),
+)
+
+// gofuzz cannot fill oneof fields, so we assert parity for messages with presence semantics explicitly
+var _ = DescribeTable("dynamic parity presence", func(in itemMessage) {
+	ExpectDynamicParity(in, dynamicDescriptor(in))
+},
+	Entry("zero", &messagev1.FieldPresence{}),
+	Entry("oneof str", &messagev1.FieldPresence{Oo: &messagev1.FieldPresence_OneofStr{}}),
+	Entry("oneof msg", &messagev1.FieldPresence{Oo: &messagev1.FieldPresence_OneofMsg{OneofMsg: &messagev1.Engine{Brand: "foo"}}}),
+	Entry("values at zero", &messagev1.FieldPresence{
+		OptStr:    proto.String(""),
+		Msg:       &messagev1.Engine{},
+		OptMsg:    &messagev1.Engine{},
+		OptEnum:   messagev1.Dirtyness_DIRTYNESS_UNSPECIFIED.Enum(),
+		StrVal:    wrapperspb.String(""),
+		BoolVal:   wrapperspb.Bool(false),
+		DoubleVal: wrapperspb.Double(0),
+		Uint64Val: wrapperspb.UInt64(0),
+	}),
+	Entry("json oneof str", &messagev1.JsonOneofs{JsonOo: &messagev1.JsonOneofs_OneofStr{OneofStr: "foo"}}),
+	Entry("json oneof msg", &messagev1.JsonOneofs{JsonOo: &messagev1.JsonOneofs_OneofMsg{OneofMsg: &messagev1.Engine{Brand: "bar"}}}),
+)
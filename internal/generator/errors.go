@@ -0,0 +1,51 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SourceError is a generation error for a message or field. It names the proto file and the position in
+// it so protoc users can find the declaration that caused it.
+type SourceError struct {
+	File   string                // path of the proto file
+	Line   int                   // line of the declaration, or 0 without source info
+	Column int                   // column of the declaration, or 0 without source info
+	Name   protoreflect.FullName // full name of the message or field
+	Err    error
+}
+
+// Error formats the error like compilers do: 'file:line:column: name: error'
+func (e *SourceError) Error() string {
+	if e.Line < 1 {
+		return fmt.Sprintf("%s: %s: %v", e.File, e.Name, e.Err)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s: %v", e.File, e.Line, e.Column, e.Name, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
+// sourceError wraps 'err' with the source location of descriptor 'd'. Errors that already have a source
+// location are not wrapped again.
+func sourceError(d protoreflect.Descriptor, err error) error {
+	var serr *SourceError
+	if err == nil || errors.As(err, &serr) {
+		return err
+	}
+
+	serr = &SourceError{File: d.ParentFile().Path(), Name: d.FullName(), Err: err}
+	if loc := d.ParentFile().SourceLocations().ByDescriptor(d); loc.Path != nil {
+		serr.Line, serr.Column = loc.StartLine+1, loc.StartColumn+1
+	}
+	return serr
+}
+
+// sourceErrorf formats an error with the source location of descriptor 'd'
+func sourceErrorf(d protoreflect.Descriptor, format string, v ...any) error {
+	return sourceError(d, fmt.Errorf(format, v...))
+}
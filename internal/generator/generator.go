@@ -8,15 +8,28 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // Config for configuring the generator
-type Config struct{}
+type Config struct {
+	Lint        bool       // check the protos for best practices instead of generating code
+	LintEnable  []LintRule // opt-in lint rules that are checked
+	LintErrors  []LintRule // lint rules whose findings fail the run instead of being warnings, opt-in rules included
+	LintDisable []LintRule // lint rules that are not checked
+
+	// WellKnownPaths maps messages with an encoding that is registered at runtime to their path struct
+	WellKnownPaths map[protoreflect.FullName]protogen.GoIdent
+	// PathPackages lists packages whose path building is generated by this plugin, even though their
+	// files don't import the plugin's options
+	PathPackages []protogen.GoImportPath
+}
 
 // Generator generates DynamoDB helper functions
 type Generator struct {
-	cfg  Config
-	logs *zap.Logger
+	cfg     Config
+	logs    *zap.Logger
+	modPath string
 }
 
 // NewGenerator inits the generator
@@ -26,25 +39,32 @@ func NewGenerator(logs *zap.Logger, opts Config) (g *Generator, err error) {
 		cfg:  opts,
 	}
 
+	// the generated code imports the runtime packages of the module the generator is build from
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return nil, fmt.Errorf("failed to read build info: binary not build with modules support")
+	}
+
+	g.modPath = bi.Path
 	return g, nil
 }
 
 // CreateTarget inits a target for a generator
 func (g Generator) CreateTarget(pf *protogen.File, ddbimport string) *Target {
 	tg := &Target{
-		src:  pf,
-		logs: g.logs.Named(fmt.Sprintf("target[%s]", *pf.Proto.Name)),
+		src:            pf,
+		logs:           g.logs.Named(fmt.Sprintf("target[%s]", *pf.Proto.Name)),
+		wellKnownPaths: g.cfg.WellKnownPaths,
+		pathPackages:   map[protogen.GoImportPath]bool{},
 	}
-
-	bi, ok := debug.ReadBuildInfo()
-	if !ok {
-		panic("failed to read build info: binary not build with modules support")
+	for _, imp := range g.cfg.PathPackages {
+		tg.pathPackages[imp] = true
 	}
 
 	// tg idents provides various identifiers
-	tg.idents.ddb = path.Join(bi.Path, "ddb")
-	tg.idents.ddbpath = path.Join(bi.Path, "ddb", "ddbpath")
-	tg.idents.ddbv1 = path.Join(bi.Path, "proto", "ddb", "v1")
+	tg.idents.ddb = path.Join(g.modPath, "ddb")
+	tg.idents.ddbpath = path.Join(g.modPath, "ddb", "ddbpath")
+	tg.idents.ddbv1 = path.Join(g.modPath, "proto", "ddb", "v1")
 	tg.idents.ddbimp = ddbimport
 	return tg
 }
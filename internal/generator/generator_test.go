@@ -1,6 +1,7 @@
 package generator_test
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -74,6 +75,56 @@ var _ = Describe("handling example messages", func() {
 		Expect((&messagev1.Kitchen{}).DynamoSortKeyName()).To(Equal(expression.Name("3")))
 	})
 
+	DescribeTable("key conditions", func(c interface{ IndexName() *string }, kc expression.KeyConditionBuilder,
+		expIndex *string, expCondition string, expValues map[string]types.AttributeValue,
+	) {
+		Expect(c.IndexName()).To(Equal(expIndex))
+
+		expr, err := expression.NewBuilder().WithKeyCondition(kc).Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*expr.KeyCondition()).To(Equal(expCondition))
+		Expect(expr.Values()).To(Equal(expValues))
+	},
+		Entry("partition only",
+			messagev1ddbpath.CarKeyCond(4), messagev1ddbpath.CarKeyCond(4).KeyCondition(),
+			nil, "#0 = :0",
+			map[string]types.AttributeValue{":0": &types.AttributeValueMemberN{Value: "4"}}),
+		Entry("bytes sort key between",
+			messagev1ddbpath.KitchenKeyCond("foo"), messagev1ddbpath.KitchenKeyCond("foo").SortKeyBetween([]byte{1}, []byte{2}),
+			nil, "(#0 = :0) AND (#1 BETWEEN :1 AND :2)",
+			map[string]types.AttributeValue{
+				":0": &types.AttributeValueMemberS{Value: "foo"},
+				":1": &types.AttributeValueMemberB{Value: []byte{1}},
+				":2": &types.AttributeValueMemberB{Value: []byte{2}},
+			}),
+		Entry("bytes sort key begins with",
+			messagev1ddbpath.KitchenKeyCond("foo"), messagev1ddbpath.KitchenKeyCond("foo").SortKeyBeginsWith([]byte{1, 0xFF}),
+			nil, "(#0 = :0) AND (#1 BETWEEN :1 AND :2)",
+			map[string]types.AttributeValue{
+				":0": &types.AttributeValueMemberS{Value: "foo"},
+				":1": &types.AttributeValueMemberB{Value: []byte{1, 0xFF}},
+				":2": &types.AttributeValueMemberB{Value: append([]byte{1}, bytes.Repeat([]byte{0xFF}, 1023)...)},
+			}),
+		Entry("bytes sort key begins with nothing",
+			messagev1ddbpath.KitchenKeyCond("foo"), messagev1ddbpath.KitchenKeyCond("foo").SortKeyBeginsWith(nil),
+			nil, "#0 = :0",
+			map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "foo"}}),
+		Entry("global index string sort key",
+			messagev1ddbpath.KitchenByForksKeyCond(3), messagev1ddbpath.KitchenByForksKeyCond(3).SortKeyBeginsWith("ba"),
+			lo.ToPtr("by_forks"), "(#0 = :0) AND (begins_with (#1, :1))",
+			map[string]types.AttributeValue{
+				":0": &types.AttributeValueMemberN{Value: "3"},
+				":1": &types.AttributeValueMemberS{Value: "ba"},
+			}),
+		Entry("local index number sort key",
+			messagev1ddbpath.KitchenByKnifesKeyCond("foo"), messagev1ddbpath.KitchenByKnifesKeyCond("foo").SortKeyGreaterThan(10),
+			lo.ToPtr("by_knifes"), "(#0 = :0) AND (#1 > :1)",
+			map[string]types.AttributeValue{
+				":0": &types.AttributeValueMemberS{Value: "foo"},
+				":1": &types.AttributeValueMemberN{Value: "10"},
+			}),
+	)
+
 	It("should handle omit tags correctly", func() {
 		msgt := reflect.TypeOf(&messagev1.Ignored{})
 		_, ok := msgt.MethodByName("SortKey")
@@ -347,7 +398,7 @@ var _ = DescribeTable("json embed fuzz", func(seed int64) {
 	fmt.Fprintf(GinkgoWriter, "Fuzz Seed: %d", seed)
 	for i := 0; i < 10000; i++ {
 		var in, out messagev1.JsonFields
-		f.Funcs(PbDurationFuzz, PbTimestampFuzz, PbValueFuzz).Fuzz(&in)
+		f.Funcs(PbDurationFuzz, PbTimestampFuzz, PbValueFuzz, PbStructFuzz, PbListValueFuzz).Fuzz(&in)
 
 		item, err := in.MarshalDynamoItem()
 		if err != nil && strings.Contains(err.Error(), "map key cannot be empty") {
@@ -369,7 +420,7 @@ var _ = DescribeTable("kitchen fuzz", func(seed int64) {
 	fmt.Fprintf(GinkgoWriter, "Fuzz Seed: %d", seed)
 	for i := 0; i < 10000; i++ {
 		var in, out messagev1.Kitchen
-		f.Funcs(PbDurationFuzz, PbTimestampFuzz, PbValueFuzz).Fuzz(&in)
+		f.Funcs(PbDurationFuzz, PbTimestampFuzz, PbValueFuzz, PbStructFuzz, PbListValueFuzz).Fuzz(&in)
 
 		item, err := in.MarshalDynamoItem()
 		if err != nil && strings.Contains(err.Error(), "map key cannot be empty") {
@@ -378,6 +429,7 @@ var _ = DescribeTable("kitchen fuzz", func(seed int64) {
 
 		Expect(err).ToNot(HaveOccurred())
 		Expect(out.UnmarshalDynamoItem(item)).To(Succeed())
+		NormalizeSets(in.ProtoReflect()) // sets are stored sorted and without duplicates
 		ExpectProtoEqual(&in, &out)
 	}
 },
@@ -393,7 +445,7 @@ var _ = DescribeTable("map galore fuzz", func(seed int64) {
 	fmt.Fprintf(GinkgoWriter, "Fuzz Seed: %d", seed)
 	for i := 0; i < 10000; i++ {
 		var in, out messagev1.MapGalore
-		f.Funcs(PbDurationFuzz, PbTimestampFuzz, PbValueFuzz).Fuzz(&in)
+		f.Funcs(PbDurationFuzz, PbTimestampFuzz, PbValueFuzz, PbStructFuzz, PbListValueFuzz).Fuzz(&in)
 		item, err := in.MarshalDynamoItem()
 		if err != nil && strings.Contains(err.Error(), "map key cannot be empty") {
 			continue // skip, unsupported variant
@@ -408,13 +460,30 @@ var _ = DescribeTable("map galore fuzz", func(seed int64) {
 	Entry("duration map", int64(1678219381135764000)),
 )
 
+var _ = DescribeTable("set galore fuzz", func(seed int64) {
+	f := fuzz.NewWithSeed(seed).NilChance(0.5)
+	fmt.Fprintf(GinkgoWriter, "Fuzz Seed: %d", seed)
+	for i := 0; i < 10000; i++ {
+		var in, out messagev1.SetGalore
+		f.Fuzz(&in)
+		item, err := in.MarshalDynamoItem()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.UnmarshalDynamoItem(item)).To(Succeed())
+		NormalizeSets(in.ProtoReflect()) // sets are stored sorted and without duplicates
+		ExpectProtoEqual(&in, &out)
+	}
+},
+	// Table entries allow seeds that detected a regression to be used as future test cases
+	Entry("now()", time.Now().UnixNano()),
+)
+
 // We fuzz structpb values in particular
 var _ = DescribeTable("value galore fuzz", func(seed int64) {
 	f := fuzz.NewWithSeed(seed).NilChance(0.5)
 	fmt.Fprintf(GinkgoWriter, "Fuzz Seed: %d", seed)
 	for i := 0; i < 10000; i++ {
 		var in, out messagev1.ValueGalore
-		f.Funcs(PbDurationFuzz, PbTimestampFuzz, PbValueFuzz).Fuzz(&in)
+		f.Funcs(PbDurationFuzz, PbTimestampFuzz, PbValueFuzz, PbStructFuzz, PbListValueFuzz).Fuzz(&in)
 		item, err := in.MarshalDynamoItem()
 		if err != nil && strings.Contains(err.Error(), "map key cannot be empty") {
 			continue // skip, unsupported variant
@@ -429,6 +498,59 @@ var _ = DescribeTable("value galore fuzz", func(seed int64) {
 	Entry("first test", int64(1678219381135764000)),
 )
 
+var _ = Describe("structpb structs and list values", func() {
+	It("should marshal them as maps and lists", func() {
+		sv, _ := structpb.NewStruct(map[string]any{"foo": "bar", "dar": []any{1.0, true}})
+		lv, _ := structpb.NewList([]any{"a", map[string]any{"b": nil}})
+		in := &messagev1.ValueGalore{
+			SomeStruct: sv,
+			SomeList:   lv,
+			Structs:    []*structpb.Struct{sv, {}},
+			Lists:      map[string]*structpb.ListValue{"x": lv, "y": {}},
+		}
+
+		item, err := in.MarshalDynamoItem()
+		Expect(err).ToNot(HaveOccurred())
+
+		m := &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+			"foo": &types.AttributeValueMemberS{Value: "bar"},
+			"dar": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+				&types.AttributeValueMemberN{Value: "1"}, &types.AttributeValueMemberBOOL{Value: true},
+			}},
+		}}
+		l := &types.AttributeValueMemberL{Value: []types.AttributeValue{
+			&types.AttributeValueMemberS{Value: "a"},
+			&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+				"b": &types.AttributeValueMemberNULL{Value: true},
+			}},
+		}}
+		Expect(item).To(Equal(map[string]types.AttributeValue{
+			"2": m,
+			"3": l,
+			"4": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+				m, &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}},
+			}},
+			"5": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+				"x": l, "y": &types.AttributeValueMemberL{Value: []types.AttributeValue{}},
+			}},
+		}))
+
+		var out messagev1.ValueGalore
+		Expect(out.UnmarshalDynamoItem(item)).To(Succeed())
+		ExpectProtoEqual(&out, in)
+	})
+
+	It("should not unmarshal them from other attributes", func() {
+		var out messagev1.ValueGalore
+		Expect(out.UnmarshalDynamoItem(map[string]types.AttributeValue{
+			"2": &types.AttributeValueMemberS{Value: "foo"},
+		})).To(MatchError(ContainSubstring("failed to unmarshal struct: no map attribute provided")))
+		Expect(out.UnmarshalDynamoItem(map[string]types.AttributeValue{
+			"3": &types.AttributeValueMemberM{},
+		})).To(MatchError(ContainSubstring("failed to unmarshal list value: no list attribute provided")))
+	})
+})
+
 // Assert that fields present in the resuling map are the same as present in canonical json encoding.
 // This works if we have a message where dynamo attr names are explicitely set to match that of the
 // the json encoding.
@@ -560,6 +682,18 @@ func PbTimestampFuzz(s *timestamppb.Timestamp, c fuzz.Continue) {
 	*s = *timestamppb.New(time.Unix(c.Rand.Int63n(max)-(max/2), int64(c.RandUint64())))
 }
 
+// PbStructFuzz fuzzes structs, since go fuzz can't fill them with values that survive a round trip
+func PbStructFuzz(s *structpb.Struct, c fuzz.Continue) {
+	sv, _ := structpb.NewStruct(map[string]any{c.RandString(): c.RandString(), c.RandString(): c.RandBool()})
+	s.Fields = sv.Fields
+}
+
+// PbListValueFuzz fuzzes list values, since go fuzz can't fill them with values that survive a round trip
+func PbListValueFuzz(s *structpb.ListValue, c fuzz.Continue) {
+	lv, _ := structpb.NewList([]any{c.RandString(), c.ExpFloat64()})
+	s.Values = lv.Values
+}
+
 // PbValueFuzz fuzzes code for structpb value. It doesn't recurse because go fuzz can't handle
 // maps or lists with interface values.
 func PbValueFuzz(s *structpb.Value, c fuzz.Continue) {
@@ -0,0 +1,220 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// LintRule names a best practice check of the lint mode
+type LintRule string
+
+const (
+	// LintReservedName reports explicit attribute names that are DynamoDB reserved words, expressions
+	// need a '#' alias to refer to them.
+	LintReservedName LintRule = "reserved-name"
+	// LintFloatKey reports float and double key fields, rounding makes them unreliable to look items up by.
+	LintFloatKey LintRule = "float-key"
+	// LintDeepNesting reports messages that nest deeper than DynamoDB allows, or that are recursive.
+	LintDeepNesting LintRule = "deep-nesting"
+	// LintUnboundedRepeated reports repeated and map fields of messages that are not embedded, they grow
+	// the item towards DynamoDB's item size limit. Whether that is a problem depends on how many messages
+	// the field holds, which the protos don't tell, so the rule is opt-in.
+	LintUnboundedRepeated LintRule = "unbounded-repeated"
+)
+
+// LintRules holds every lint rule, in the order they are checked
+var LintRules = []LintRule{
+	LintReservedName, LintFloatKey, LintDeepNesting, LintUnboundedRepeated,
+}
+
+// LintOptIn holds the lint rules that are only checked when they are enabled, or configured as errors
+var LintOptIn = []LintRule{
+	LintUnboundedRepeated,
+}
+
+// MaxNestingDepth is the number of levels that DynamoDB allows maps and lists to be nested
+const MaxNestingDepth = 32
+
+// ParseLintRules parses a comma separated list of lint rule names, 'all' selects every rule
+func ParseLintRules(s string) (rules []LintRule, err error) {
+	for _, name := range strings.Split(s, ",") {
+		switch name = strings.TrimSpace(name); name {
+		case "":
+			continue
+		case "all":
+			rules = append(rules, LintRules...)
+			continue
+		}
+
+		rule, ok := LintRule(name), false
+		for _, r := range LintRules {
+			ok = ok || r == rule
+		}
+		if !ok {
+			return nil, fmt.Errorf("unknown lint rule '%s'", name)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// LintFinding is a problem that a lint rule found in the protos
+type LintFinding struct {
+	Rule LintRule
+	Err  error // describes the problem, with its source location
+}
+
+// Error formats the finding with the rule that found it
+func (f LintFinding) Error() string {
+	return fmt.Sprintf("%v (%s)", f.Err, f.Rule)
+}
+
+// Unwrap returns the error that describes the problem
+func (f LintFinding) Unwrap() error {
+	return f.Err
+}
+
+// Lint checks the target's protos without generating code. Problems that fail code generation, such as
+// attribute name collisions, are returned as errors, lint findings are written to 'w' as warnings unless configured to be errors.
+// Findings of opt-in rules are only reported when the rule is enabled or configured to be an error.
+func (g Generator) Lint(tg *Target, pkgSuffix string, w io.Writer) error {
+	var errs []error
+	if err := tg.GenerateMessageLogic(io.Discard); err != nil {
+		errs = append(errs, err)
+	} else if err := tg.GeneratePathBuilding(io.Discard, pkgSuffix); err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, f := range tg.Lint() {
+		switch {
+		case hasLintRule(g.cfg.LintDisable, f.Rule):
+			continue
+		case hasLintRule(LintOptIn, f.Rule) && !hasLintRule(g.cfg.LintEnable, f.Rule) &&
+			!hasLintRule(g.cfg.LintErrors, f.Rule):
+			continue
+		case hasLintRule(g.cfg.LintErrors, f.Rule):
+			errs = append(errs, f)
+		default:
+			fmt.Fprintf(w, "warning: %v\n", f)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// hasLintRule returns whether 'rule' is part of 'rules'
+func hasLintRule(rules []LintRule, rule LintRule) bool {
+	for _, r := range rules {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// Lint checks the messages of the target against every lint rule
+func (tg *Target) Lint() (fs []LintFinding) {
+	finding := func(rule LintRule, d protoreflect.Descriptor, format string, v ...any) {
+		fs = append(fs, LintFinding{Rule: rule, Err: sourceErrorf(d, format, v...)})
+	}
+
+	for _, m := range tg.src.Messages {
+		for _, field := range m.Fields {
+			if tg.isOmitted(field) {
+				continue // not stored
+			}
+
+			name := tg.attrName(field)
+			if fopts := FieldOptions(field); fopts != nil && fopts.Name != nil && isReservedWord(name) {
+				finding(LintReservedName, field.Desc,
+					"attribute name '%s' is a reserved word, expressions need a '#' alias to refer to it", name)
+			}
+
+			isPk, isSk := tg.isKey(field)
+			gsiPk, gsiSk, lsiSk := tg.secondaryIndexNames(field)
+			isKey := isPk || isSk || len(gsiPk)+len(gsiSk)+len(lsiSk) > 0
+			if kind := field.Desc.Kind(); isKey && (kind == protoreflect.FloatKind || kind == protoreflect.DoubleKind) {
+				finding(LintFloatKey, field.Desc,
+					"key field '%s' is a %s, rounding makes floating point keys unreliable to look items up by", field.GoName, kind)
+			}
+
+			if elem := tg.nestedElemMessage(field); elem != nil && !tg.isEmbedded(field) && tg.codecName(field) == "" {
+				finding(LintUnboundedRepeated, field.Desc,
+					"field '%s' holds any number of '%s' messages in the item, consider an embed encoding or separate items",
+					field.GoName, elem.Desc.FullName())
+			}
+		}
+
+		depth, through := tg.nestingDepth(m, map[protoreflect.FullName]bool{})
+		switch {
+		case through != nil:
+			finding(LintDeepNesting, m.Desc,
+				"message '%s' is recursive through field '%s', its items can nest deeper than DynamoDB's %d levels",
+				m.GoIdent.GoName, through.Desc.FullName(), MaxNestingDepth)
+		case depth > MaxNestingDepth:
+			finding(LintDeepNesting, m.Desc,
+				"message '%s' nests %d levels deep, DynamoDB allows %d levels", m.GoIdent.GoName, depth, MaxNestingDepth)
+		}
+	}
+	return fs
+}
+
+// nestedElemMessage returns the message of the elements of a list or map field, if they are stored as
+// nested maps.
+func (tg *Target) nestedElemMessage(field *protogen.Field) *protogen.Message {
+	switch {
+	case field.Desc.IsMap():
+		field = field.Message.Fields[1] // value type of the map
+	case !field.Desc.IsList():
+		return nil
+	}
+
+	if field.Message == nil || tg.messageAttributeType(field.Message) != "Map" {
+		return nil
+	}
+	return field.Message
+}
+
+// nestingDepth returns how many levels of maps and lists the items of message 'm' nest. If the message
+// is recursive it returns the field through which it recurses. Values that can be of any type are not
+// counted since their depth isn't known until runtime.
+func (tg *Target) nestingDepth(m *protogen.Message, visiting map[protoreflect.FullName]bool) (depth int, through *protogen.Field) {
+	visiting[m.Desc.FullName()] = true
+	defer delete(visiting, m.Desc.FullName())
+
+	for _, field := range m.Fields {
+		if tg.isOmitted(field) || tg.isEmbedded(field) || tg.codecName(field) != "" {
+			continue // not stored, or stored as a string or however the codec likes
+		}
+
+		var fdepth int
+		nested := field.Message
+		switch {
+		case field.Desc.IsMap() || field.Desc.IsList():
+			fdepth, nested = 1, tg.nestedElemMessage(field)
+		case nested != nil && tg.messageAttributeType(nested) != "Map":
+			nested = nil
+		}
+
+		if nested != nil {
+			if visiting[nested.Desc.FullName()] {
+				return 0, field
+			}
+
+			ndepth, nthrough := tg.nestingDepth(nested, visiting)
+			if nthrough != nil {
+				return 0, nthrough
+			}
+			fdepth += 1 + ndepth
+		}
+
+		if fdepth > depth {
+			depth = fdepth
+		}
+	}
+	return depth, nil
+}
@@ -0,0 +1,165 @@
+package generator_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/crewlinker/protoc-gen-dynamodb/internal/generator"
+	ddbv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var _ = DescribeTable("parse lint rules", func(s string, exp []generator.LintRule, expErr string) {
+	rules, err := generator.ParseLintRules(s)
+	if expErr != "" {
+		Expect(err).To(MatchError(expErr))
+		return
+	}
+
+	Expect(err).ToNot(HaveOccurred())
+	Expect(rules).To(Equal(exp))
+},
+	Entry("empty", "", []generator.LintRule(nil), ""),
+	Entry("single rule", "float-key", []generator.LintRule{generator.LintFloatKey}, ""),
+	Entry("multiple rules with spaces", "float-key, deep-nesting",
+		[]generator.LintRule{generator.LintFloatKey, generator.LintDeepNesting}, ""),
+	Entry("all rules", "all", generator.LintRules, ""),
+	Entry("unknown rule", "float-key,bogus", nil, "unknown lint rule 'bogus'"),
+)
+
+// lintTarget inits a target for a proto file that is described in text format, the file can use the
+// plugin's options
+func lintTarget(cfg generator.Config, src string) *generator.Target {
+	fdp := &descriptorpb.FileDescriptorProto{}
+	Expect(prototext.Unmarshal([]byte(src), fdp)).To(Succeed())
+	fdp.Name = proto.String("lint/v1/lint.proto")
+	fdp.Package = proto.String("lint.v1")
+	fdp.Syntax = proto.String("proto3")
+	fdp.Dependency = []string{"ddb/v1/options.proto"}
+	fdp.Options = &descriptorpb.FileOptions{GoPackage: proto.String("example.com/lint/v1;lintv1")}
+
+	gp, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{fdp.GetName()},
+		ProtoFile: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto),
+			protodesc.ToFileDescriptorProto(ddbv1.File_ddb_v1_options_proto),
+			fdp,
+		},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	gen, err := generator.NewGenerator(zap.NewNop(), cfg)
+	Expect(err).ToNot(HaveOccurred())
+	return gen.CreateTarget(gp.FilesByPath[fdp.GetName()], "")
+}
+
+// lintFindings formats the findings of linting a proto file that is described in text format
+func lintFindings(src string) (fs []string) {
+	for _, f := range lintTarget(generator.Config{}, src).Lint() {
+		fs = append(fs, f.Error())
+	}
+	return fs
+}
+
+// nestedMessages describes 'n' messages that each nest the next one
+func nestedMessages(n int) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, `message_type { name: "M%d" field { name: "next" number: 1 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".lint.v1.M%d" } } `, i, i+1)
+	}
+	fmt.Fprintf(&b, `message_type { name: "M%d" field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING } }`, n)
+	return b.String()
+}
+
+var _ = DescribeTable("lint rules", func(src string, exp []string) {
+	fs := lintFindings(src)
+	Expect(fs).To(HaveLen(len(exp)))
+	for i, e := range exp {
+		Expect(fs[i]).To(MatchRegexp(e))
+	}
+},
+	Entry("reserved explicit name", `message_type { name: "Linted" field {
+		name: "state" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING options { [ddb.v1.field] { name: "status" } } } }`,
+		[]string{`attribute name 'status' is a reserved word, .* \(reserved-name\)$`}),
+	Entry("reserved field name without explicit name", `message_type { name: "Linted" field {
+		name: "status" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING } }`,
+		nil),
+	Entry("float partition key", `message_type { name: "Linted" field {
+		name: "pk" number: 1 label: LABEL_OPTIONAL type: TYPE_DOUBLE options { [ddb.v1.field] { pk: true } } } }`,
+		[]string{`key field 'Pk' is a double, .* \(float-key\)$`}),
+	Entry("float index key", `message_type { name: "Linted" field {
+		name: "score" number: 1 label: LABEL_OPTIONAL type: TYPE_FLOAT options { [ddb.v1.field] { gsi_sk: "by_score" } } } }`,
+		[]string{`key field 'Score' is a float, .* \(float-key\)$`}),
+	Entry("float field that is not a key", `message_type { name: "Linted" field {
+		name: "score" number: 1 label: LABEL_OPTIONAL type: TYPE_FLOAT } }`,
+		nil),
+	Entry("recursive message", `message_type { name: "Linted" field {
+		name: "child" number: 1 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".lint.v1.Linted" } }`,
+		[]string{`message 'Linted' is recursive through field 'lint.v1.Linted.child', .* \(deep-nesting\)$`}),
+	Entry("recursive message that is embedded", `message_type { name: "Linted" field {
+		name: "child" number: 1 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".lint.v1.Linted"
+		options { [ddb.v1.field] { embed: ENCODING_JSON } } } }`,
+		nil),
+	Entry("nesting at the limit", nestedMessages(generator.MaxNestingDepth), nil),
+	Entry("nesting beyond the limit", nestedMessages(generator.MaxNestingDepth+1),
+		[]string{`message 'M0' nests 33 levels deep, DynamoDB allows 32 levels \(deep-nesting\)$`}),
+	Entry("repeated message", `message_type { name: "Item" } message_type { name: "Linted" field {
+		name: "items" number: 1 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".lint.v1.Item" } }`,
+		[]string{`field 'Items' holds any number of 'lint.v1.Item' messages in the item, .* \(unbounded-repeated\)$`}),
+	Entry("repeated message that is embedded", `message_type { name: "Item" } message_type { name: "Linted" field {
+		name: "items" number: 1 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".lint.v1.Item"
+		options { [ddb.v1.field] { embed: ENCODING_JSON } } } }`,
+		nil),
+	Entry("repeated scalar", `message_type { name: "Linted" field {
+		name: "tags" number: 1 label: LABEL_REPEATED type: TYPE_STRING } }`,
+		nil),
+)
+
+var _ = Describe("lint", func() {
+	src := `message_type { name: "Item" } message_type { name: "Linted" field {
+		name: "pk" number: 1 label: LABEL_OPTIONAL type: TYPE_DOUBLE options { [ddb.v1.field] { pk: true } } } field {
+		name: "items" number: 2 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".lint.v1.Item" } }`
+
+	lint := func(cfg generator.Config) (string, error) {
+		gen, err := generator.NewGenerator(zap.NewNop(), cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		var out bytes.Buffer
+		err = gen.Lint(lintTarget(cfg, src), "ddbpath", &out)
+		return out.String(), err
+	}
+
+	It("should not report opt-in rules by default", func() {
+		out, err := lint(generator.Config{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(ContainSubstring(`(float-key)`))
+		Expect(out).ToNot(ContainSubstring(`(unbounded-repeated)`))
+	})
+
+	It("should report opt-in rules that are enabled", func() {
+		out, err := lint(generator.Config{LintEnable: []generator.LintRule{generator.LintUnboundedRepeated}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(ContainSubstring(`(unbounded-repeated)`))
+	})
+
+	It("should fail on opt-in rules that are errors", func() {
+		out, err := lint(generator.Config{LintErrors: []generator.LintRule{generator.LintUnboundedRepeated}})
+		Expect(err).To(MatchError(ContainSubstring(`(unbounded-repeated)`)))
+		Expect(out).To(ContainSubstring(`(float-key)`))
+	})
+
+	It("should not report disabled rules", func() {
+		out, err := lint(generator.Config{LintEnable: generator.LintRules, LintDisable: generator.LintRules})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(BeEmpty())
+	})
+})
@@ -70,10 +70,33 @@ func (tg *Target) embedEncoding(f *protogen.Field) ddbv1.Encoding {
 	return ddbv1.Encoding_ENCODING_UNSPECIFIED
 }
 
+// codecName returns the name of the codec that marshals the field, or an empty string if it has none
+func (tg *Target) codecName(f *protogen.Field) string {
+	if fopts := FieldOptions(f); fopts != nil && fopts.Codec != nil {
+		return *fopts.Codec
+	}
+	return ""
+}
+
 // notSupportPathing returns wether a field doesn't support deep pathing
 func (tg *Target) notSupportPathing(field *protogen.Field) bool {
 	return field.Message == nil || // if field is not a message, never support pathing
-		(!tg.isSamePkgIdent(field.Message.GoIdent) && !tg.isWellKnownPathSupported(field.Message)) ||
-		(tg.embedEncoding(field) != ddbv1.Encoding_ENCODING_DYNAMO &&
-			tg.embedEncoding(field) != ddbv1.Encoding_ENCODING_UNSPECIFIED)
+		(!tg.isWellKnownPathSupported(field.Message) && !tg.hasGeneratedPaths(field.Message)) ||
+		tg.isEmbedded(field) || tg.codecName(field) != ""
+}
+
+// isEmbedded returns whether a field is stored with an embedded encoding, such as JSON
+func (tg *Target) isEmbedded(field *protogen.Field) bool {
+	return tg.embedEncoding(field) != ddbv1.Encoding_ENCODING_DYNAMO &&
+		tg.embedEncoding(field) != ddbv1.Encoding_ENCODING_UNSPECIFIED
+}
+
+// secondaryIndexNames returns the names of the global indexes the field is the partition key of, the global
+// indexes it is the sort key of and the local indexes it is the sort key of.
+func (tg *Target) secondaryIndexNames(f *protogen.Field) (gsiPk, gsiSk, lsiSk []string) {
+	if fopts := FieldOptions(f); fopts != nil {
+		return fopts.GsiPk, fopts.GsiSk, fopts.LsiSk
+	}
+
+	return nil, nil, nil
 }
@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"errors"
 	"fmt"
 	"io"
 
@@ -20,9 +21,11 @@ const (
 
 // Target facilitates generation from a single protobuf file
 type Target struct {
-	src    *protogen.File
-	logs   *zap.Logger
-	idents struct {
+	src            *protogen.File
+	logs           *zap.Logger
+	wellKnownPaths map[protoreflect.FullName]protogen.GoIdent // path structs of configured well-knowns
+	pathPackages   map[protogen.GoImportPath]bool             // packages with path building of this plugin
+	idents         struct {
 		ddb     string
 		ddbv1   string
 		ddbpath string
@@ -47,6 +50,27 @@ func (tg *Target) isSamePkgIdent(ident protogen.GoIdent) bool {
 	return ident.GoImportPath == tg.src.GoImportPath
 }
 
+// optionsFile is the proto file that declares the options of this plugin
+const optionsFile = "ddb/v1/options.proto"
+
+// hasGeneratedPaths returns true if path building was generated by this plugin for message 'm'. We only
+// know that for the package we're generating for, for files that import our options and for packages
+// that are configured through the 'path_package' option. Others, such as genproto's google.type
+// messages, have no ddbpath package to refer to.
+func (tg *Target) hasGeneratedPaths(m *protogen.Message) bool {
+	if tg.isSamePkgIdent(m.GoIdent) || tg.pathPackages[m.GoIdent.GoImportPath] {
+		return true
+	}
+
+	imps := m.Desc.ParentFile().Imports()
+	for i := 0; i < imps.Len(); i++ {
+		if imps.Get(i).Path() == optionsFile {
+			return true
+		}
+	}
+	return false
+}
+
 // keyFields consults the the fields of the message and return the fields that describe pk/sks
 func (tg *Target) keyFields(m *protogen.Message) (pkf, skf *protogen.Field, err error) {
 	for _, field := range m.Fields {
@@ -57,28 +81,28 @@ func (tg *Target) keyFields(m *protogen.Message) (pkf, skf *protogen.Field, err
 		isPk, isSk := tg.isKey(field)
 		if isPk && isSk {
 			// check that field cannot be marked as both sk and pk
-			return nil, nil, fmt.Errorf("field '%s' is both marked as PK and as SK", field.GoName)
+			return nil, nil, sourceErrorf(field.Desc, "field '%s' is both marked as PK and as SK", field.GoName)
 		}
 
 		if isPk {
 			if pkf != nil { // only one field can be marked as PK
-				return nil, nil, fmt.Errorf("field '%s' is already marked as PK", pkf.GoName)
+				return nil, nil, sourceErrorf(field.Desc, "field '%s' is already marked as PK", pkf.GoName)
 			}
 
 			pkf = field
 			if !tg.isValidKeyField(pkf) {
-				return nil, nil, fmt.Errorf("field '%s' must be a basic type that marshals to Number,String or Bytes to be a PK", pkf.GoName)
+				return nil, nil, sourceErrorf(field.Desc, "field '%s' must be a basic type that marshals to Number,String or Bytes to be a PK", pkf.GoName)
 			}
 		}
 
 		if isSk {
 			if skf != nil { // only one field can be marked as SK
-				return nil, nil, fmt.Errorf("field '%s' is already marked as SK", skf.GoName)
+				return nil, nil, sourceErrorf(field.Desc, "field '%s' is already marked as SK", skf.GoName)
 			}
 
 			skf = field
 			if !tg.isValidKeyField(skf) {
-				return nil, nil, fmt.Errorf("field '%s' must be a basic type that marshals to Number,String or Bytes to be a SK", skf.GoName)
+				return nil, nil, sourceErrorf(field.Desc, "field '%s' must be a basic type that marshals to Number,String or Bytes to be a SK", skf.GoName)
 			}
 		}
 	}
@@ -86,7 +110,7 @@ func (tg *Target) keyFields(m *protogen.Message) (pkf, skf *protogen.Field, err
 	// if the message has a sort key, but not a partition key that doesn't make sense. The other
 	// way around is ok.
 	if pkf == nil && skf != nil {
-		return nil, nil, fmt.Errorf("message '%s' has a sort key, but not a partition key", m.GoIdent.GoName)
+		return nil, nil, sourceErrorf(m.Desc, "message '%s' has a sort key, but not a partition key", m.GoIdent.GoName)
 	}
 
 	return
@@ -120,66 +144,72 @@ func (tg *Target) isValidKeyField(f *protogen.Field) bool {
 }
 
 // fieldGoType turns a field protoreflect kind into a go type
-func (tg *Target) fieldGoType(f *protogen.Field, msgSuffix ...string) *Statement {
-	if f.Message != nil {
-		identName := f.Message.GoIdent.GoName
+func (tg *Target) fieldGoType(f *protogen.Field, msgSuffix ...string) (*Statement, error) {
+	var ident protogen.GoIdent
+	switch {
+	case f.Message != nil:
+		ident = f.Message.GoIdent
 		if len(msgSuffix) > 0 {
-			identName = identName + msgSuffix[0]
+			ident.GoName = ident.GoName + msgSuffix[0]
 		}
+	case f.Enum != nil:
+		ident = f.Enum.GoIdent
+	}
 
-		// if the message is from the same package path as we're generating for, assume we refer
+	if ident.GoName != "" {
+		// if the message or enum is from the same package path as we're generating for, assume we refer
 		// to it without fullq qualifier
-		if tg.isSamePkgIdent(f.Message.GoIdent) {
-			return Id(identName)
+		if tg.isSamePkgIdent(ident) {
+			return Id(ident.GoName), nil
 		}
 
 		// else refer to it with qualifier
-		return Qual(string(f.Message.GoIdent.GoImportPath), identName)
+		return Qual(string(ident.GoImportPath), ident.GoName), nil
 	}
 
 	switch f.Desc.Kind() {
 	case protoreflect.StringKind, protoreflect.BoolKind,
 		protoreflect.Int64Kind, protoreflect.Uint64Kind:
-		return Id(f.Desc.Kind().String())
+		return Id(f.Desc.Kind().String()), nil
 	case protoreflect.BytesKind:
-		return Id("[]byte")
+		return Id("[]byte"), nil
 	case protoreflect.Fixed64Kind:
-		return Id("uint64")
+		return Id("uint64"), nil
 	case protoreflect.Sint64Kind:
-		return Id("int64")
+		return Id("int64"), nil
 	case protoreflect.Sfixed64Kind:
-		return Id("int64")
+		return Id("int64"), nil
 	case protoreflect.Int32Kind, protoreflect.Uint32Kind:
-		return Id(f.Desc.Kind().String())
+		return Id(f.Desc.Kind().String()), nil
 	case protoreflect.Fixed32Kind:
-		return Id("uint32")
+		return Id("uint32"), nil
 	case protoreflect.Sint32Kind:
-		return Id("int32")
+		return Id("int32"), nil
 	case protoreflect.Sfixed32Kind:
-		return Id("int32")
+		return Id("int32"), nil
 	case protoreflect.DoubleKind:
-		return Id("float64")
+		return Id("float64"), nil
 	case protoreflect.FloatKind:
-		return Id("float32")
+		return Id("float32"), nil
 	default:
-		panic("unsupported field type: " + f.Desc.Kind().String())
+		return nil, fmt.Errorf("field '%s' has an unsupported kind '%s'", f.GoName, f.Desc.Kind())
 	}
 }
 
 // fieldZeroValue determines the literal that is asserted against to determine if
 // the field should be added to the result attribute map
-func (tg *Target) fieldZeroValue(f *protogen.Field) *Statement {
+func (tg *Target) fieldZeroValue(f *protogen.Field) (*Statement, error) {
 	if f.Message != nil ||
 		f.Desc.IsList() ||
 		f.Desc.HasPresence() {
-		return Nil()
+		return Nil(), nil
 	}
 
 	switch f.Desc.Kind() {
 	case protoreflect.StringKind:
-		return Lit("")
+		return Lit(""), nil
 	case protoreflect.BoolKind:
-		return Lit(false)
+		return Lit(false), nil
 	case protoreflect.Int64Kind,
 		protoreflect.Uint64Kind,
 		protoreflect.Fixed64Kind,
@@ -191,31 +221,36 @@ func (tg *Target) fieldZeroValue(f *protogen.Field) *Statement {
 		protoreflect.Sfixed32Kind,
 		protoreflect.DoubleKind,
 		protoreflect.FloatKind:
-		return Lit(0)
+		return Lit(0), nil
 	case protoreflect.BytesKind:
-		return Nil()
+		return Nil(), nil
 	case protoreflect.EnumKind:
-		return Lit(0)
+		return Lit(0), nil
 	default:
-		panic("unsupported zero value: " + f.Desc.Kind().String())
+		return nil, fmt.Errorf("field '%s' has no zero value for kind '%s'", f.GoName, f.Desc.Kind())
 	}
 }
 
 // marshalPresenceCond generates code for the if statements condition that checks if the field
 // should be included in the marshalled attribute map.
-func (tg *Target) marshalPresenceCond(f *protogen.Field) []Code {
-	switch {
-	case f.Oneof != nil && !f.Desc.HasOptionalKeyword():
+func (tg *Target) marshalPresenceCond(f *protogen.Field) ([]Code, error) {
+	if f.Desc.IsList() || f.Desc.IsMap() {
+		return []Code{Len(Id("x").Dot(f.GoName)).Op("!=").Lit(0)}, nil
+	}
+
+	zero, err := tg.fieldZeroValue(f)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Oneof != nil && !f.Desc.HasOptionalKeyword() {
 		return []Code{
 			List(Id("onev"), Id("ok")).Op(":=").Id("x").Dot(f.Oneof.GoName).Assert(Op("*").
 				Id(fmt.Sprintf("%s_%s", f.Parent.GoIdent.GoName, f.GoName))),
-			Id("ok").Op("&&").Id("onev").Op("!=").Add(tg.fieldZeroValue(f)),
-		}
-	case f.Desc.IsList(), f.Desc.IsMap():
-		return []Code{Len(Id("x").Dot(f.GoName)).Op("!=").Lit(0)}
-	default:
-		return []Code{Id("x").Dot(f.GoName).Op("!=").Add(tg.fieldZeroValue(f))}
+			Id("ok").Op("&&").Id("onev").Op("!=").Add(zero),
+		}, nil
 	}
+	return []Code{Id("x").Dot(f.GoName).Op("!=").Add(zero)}, nil
 }
 
 // GeneratePathBuilding generates code for type-safe document pathing building
@@ -225,20 +260,26 @@ func (tg *Target) GeneratePathBuilding(w io.Writer, pkgSuffix string) error {
 	f.PackageComment(fmt.Sprintf("Package %s holds generated code for working with Dynamo document paths", pkgname))
 	f.HeaderComment("Code generated by protoc-gen-dynamodb. DO NOT EDIT.")
 
-	// generate per message dynamo logic
+	// name path packages of other packages like they are declared, instead of jen's guess that collides
+	tg.importPathPackages(f, pkgSuffix)
+
+	// generate per message dynamo logic, errors of all messages are reported together
+	var errs []error
 	for _, m := range tg.src.Messages {
-		// generate the message paths
-		if err := tg.genMessagePaths(f, m); err != nil {
-			return fmt.Errorf("failed to generate message path building: %w", err)
-		}
+		errs = append(errs,
+			tg.genMessagePaths(f, m), // generate the message paths
+			tg.genDdbKeying(f, m),    // generate pk/sk methods
+		)
+	}
 
-		// generate pk/sk methods
-		if err := tg.genDdbKeying(f, m); err != nil {
-			return fmt.Errorf("failed to generate keying: %w", err)
-		}
+	if err := errors.Join(errs...); err != nil {
+		return err
 	}
 
-	return f.Render(w)
+	if err := f.Render(w); err != nil {
+		return fmt.Errorf("failed to render path building for '%s': %w", tg.src.Desc.Path(), err)
+	}
+	return nil
 }
 
 // GenerateMessageLogic peforms the actual code generation
@@ -246,25 +287,22 @@ func (tg *Target) GenerateMessageLogic(w io.Writer) error {
 	f := NewFile(string(tg.src.GoPackageName))
 	f.HeaderComment("Code generated by protoc-gen-dynamodb. DO NOT EDIT.")
 
-	// generate per message marshal/unmarshal code
+	// generate per message marshal/unmarshal code, errors of all messages are reported together
+	var errs []error
 	for _, m := range tg.src.Messages {
+		errs = append(errs,
+			tg.genMessageMarshal(f, m),   // generate the marshal method
+			tg.genMessageUnmarshal(f, m), // generate the unmarshal method
+			tg.genMessageKeying(f, m),    // generate methods that return key info
+		)
+	}
 
-		// generate the marshal method
-		if err := tg.genMessageMarshal(f, m); err != nil {
-			return fmt.Errorf("failed to generate marshal: %w", err)
-		}
-
-		// generate the unmarshal method
-		if err := tg.genMessageUnmarshal(f, m); err != nil {
-			return fmt.Errorf("failed to generate unmarshal: %w", err)
-		}
-
-		// generate methods that return key info
-		if err := tg.genMessageKeying(f, m); err != nil {
-			return fmt.Errorf("failed to generate key methods: %w", err)
-		}
-
+	if err := errors.Join(errs...); err != nil {
+		return err
 	}
 
-	return f.Render(w)
+	if err := f.Render(w); err != nil {
+		return fmt.Errorf("failed to render message logic for '%s': %w", tg.src.Desc.Path(), err)
+	}
+	return nil
 }
@@ -2,16 +2,166 @@ package generator
 
 import (
 	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
 
 	. "github.com/dave/jennifer/jen"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// secondaryIndex describes a secondary index that is declared on the fields of a message
+type secondaryIndex struct {
+	name  string
+	local bool
+	pkf   *protogen.Field
+	skf   *protogen.Field
+}
+
+// secondaryIndexes returns the secondary indexes declared on the fields of a message, sorted by name
+func (tg *Target) secondaryIndexes(m *protogen.Message, pkf *protogen.Field) (idxs []*secondaryIndex, err error) {
+	byName := map[string]*secondaryIndex{}
+	index := func(name string, local bool) (*secondaryIndex, error) {
+		idx, ok := byName[name]
+		if !ok {
+			idx = &secondaryIndex{name: name, local: local}
+			byName[name] = idx
+			idxs = append(idxs, idx)
+		}
+		if idx.local != local {
+			return nil, fmt.Errorf("index '%s' is declared both as a local and global secondary index", name)
+		}
+		return idx, nil
+	}
+
+	for _, field := range m.Fields {
+		if tg.isOmitted(field) {
+			continue // omitted, don't try to turn it into a key
+		}
+
+		gsiPk, gsiSk, lsiSk := tg.secondaryIndexNames(field)
+		if len(gsiPk)+len(gsiSk)+len(lsiSk) > 0 && !tg.isValidKeyField(field) {
+			return nil, sourceErrorf(field.Desc, "field '%s' must be a basic type that marshals to Number,String or Bytes to be an index key", field.GoName)
+		}
+
+		for _, name := range gsiPk {
+			idx, err := index(name, false)
+			if err != nil {
+				return nil, sourceError(field.Desc, err)
+			}
+			if idx.pkf != nil {
+				return nil, sourceErrorf(field.Desc, "field '%s' is already marked as PK of index '%s'", idx.pkf.GoName, name)
+			}
+			idx.pkf = field
+		}
+
+		for _, name := range gsiSk {
+			idx, err := index(name, false)
+			if err != nil {
+				return nil, sourceError(field.Desc, err)
+			}
+			if idx.skf != nil {
+				return nil, sourceErrorf(field.Desc, "field '%s' is already marked as SK of index '%s'", idx.skf.GoName, name)
+			}
+			idx.skf = field
+		}
+
+		for _, name := range lsiSk {
+			idx, err := index(name, true)
+			if err != nil {
+				return nil, sourceError(field.Desc, err)
+			}
+			if idx.skf != nil {
+				return nil, sourceErrorf(field.Desc, "field '%s' is already marked as SK of index '%s'", idx.skf.GoName, name)
+			}
+			idx.skf, idx.pkf = field, pkf
+		}
+	}
+
+	for _, idx := range idxs {
+		if idx.pkf == nil && idx.local {
+			return nil, sourceErrorf(m.Desc, "local secondary index '%s' requires the message to have a partition key", idx.name)
+		} else if idx.pkf == nil {
+			return nil, sourceErrorf(m.Desc, "global secondary index '%s' has a sort key, but not a partition key", idx.name)
+		}
+	}
+
+	sort.Slice(idxs, func(i, j int) bool { return idxs[i].name < idxs[j].name })
+	return idxs, nil
+}
+
+// indexIdentName turns an index name into a Go identifier, e.g: "by_forks" becomes "ByForks"
+func indexIdentName(name string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+// keyParamName returns the name of the parameter that holds a key value for field 'f'
+func keyParamName(f *protogen.Field) string {
+	name := f.Desc.JSONName()
+	if token.IsKeyword(name) {
+		return name + "_"
+	}
+	return name
+}
+
+// genKeyCond generates a function that returns a typed key condition builder for a table or index
+func (tg *Target) genKeyCond(f *File, fname, index string, pkf, skf *protogen.Field) error {
+	pkTyp, err := tg.fieldGoType(pkf)
+	if err != nil {
+		return sourceError(pkf.Desc, err)
+	}
+
+	param := keyParamName(pkf)
+	args := []Code{Lit(index), Lit(tg.attrName(pkf)), Id(param)}
+
+	var typ, ctor *Statement
+	switch {
+	case skf == nil:
+		typ, ctor = Qual(tg.idents.ddbpath, "PartitionKeyCond"), Qual(tg.idents.ddbpath, "NewPartitionKeyCond")
+	case skf.Desc.Kind() == protoreflect.StringKind:
+		typ, ctor = Qual(tg.idents.ddbpath, "StringKeyCond"), Qual(tg.idents.ddbpath, "NewStringKeyCond")
+		args = append(args, Lit(tg.attrName(skf)))
+	case skf.Desc.Kind() == protoreflect.BytesKind:
+		typ, ctor = Qual(tg.idents.ddbpath, "BytesKeyCond"), Qual(tg.idents.ddbpath, "NewBytesKeyCond")
+		args = append(args, Lit(tg.attrName(skf)))
+	default:
+		skTyp, err := tg.fieldGoType(skf)
+		if err != nil {
+			return sourceError(skf.Desc, err)
+		}
+
+		typ = Qual(tg.idents.ddbpath, "KeyCond").Types(skTyp)
+		ctor = Qual(tg.idents.ddbpath, "NewKeyCond").Types(skTyp)
+		args = append(args, Lit(tg.attrName(skf)))
+	}
+
+	if index == "" {
+		f.Commentf("%s returns a key condition builder for the items with partition key '%s'", fname, param)
+	} else {
+		f.Commentf("%s returns a key condition builder for the items in index '%s' with partition key '%s'",
+			fname, index, param)
+	}
+	f.Func().
+		Id(fname).
+		Params(Id(param).Add(pkTyp)).
+		Params(typ).
+		Block(Return(ctor.Call(args...)))
+	return nil
+}
+
 // genMessageKeying generates partition/sort key methods on the messages itself
 func (tg *Target) genMessageKeying(f *File, m *protogen.Message) (err error) {
 	pkf, skf, err := tg.keyFields(m)
 	if err != nil {
-		return fmt.Errorf("failed to determine key fields: %w", err)
+		return err // reported with the location of the key field
 	}
 
 	// if no key fields are configured, so we don't generate a MarshalDynamoKey at all
@@ -77,7 +227,19 @@ func (tg *Target) genMessageKeying(f *File, m *protogen.Message) (err error) {
 func (tg *Target) genDdbKeying(f *File, m *protogen.Message) (err error) {
 	pkf, skf, err := tg.keyFields(m)
 	if err != nil {
-		return fmt.Errorf("failed to determine key fields: %w", err)
+		return err // reported with the location of the key field
+	}
+
+	// typed key condition builders for each of the secondary indexes
+	idxs, err := tg.secondaryIndexes(m, pkf)
+	if err != nil {
+		return err // reported with the location of the index field or message
+	}
+
+	for _, idx := range idxs {
+		if err = tg.genKeyCond(f, m.GoIdent.GoName+indexIdentName(idx.name)+"KeyCond", idx.name, idx.pkf, idx.skf); err != nil {
+			return err
+		}
 	}
 
 	// if no key fields are configured, so we don't generate a MarshalDynamoKey at all
@@ -139,6 +301,13 @@ func (tg *Target) genDdbKeying(f *File, m *protogen.Message) (err error) {
 			Block(Return(Qual(expression, "Name").Call(Lit(tg.attrName(skf)))))
 	}
 
+	// typed key condition builder for the table
+	if pkf != nil {
+		if err = tg.genKeyCond(f, m.GoIdent.GoName+"KeyCond", "", pkf, skf); err != nil {
+			return err
+		}
+	}
+
 	// static function that returns the key names for a certain message
 	f.Commentf("%sKeyNames returns the attribute names of the partition and sort keys respectively", m.GoIdent.GoName)
 	f.Func().
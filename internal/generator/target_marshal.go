@@ -1,14 +1,16 @@
 package generator
 
 import (
+	"errors"
 	"fmt"
 
 	. "github.com/dave/jennifer/jen"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // generate marshalling code for a map field
-func (tg *Target) genMapFieldMarshal(f *protogen.Field) (c []Code) {
+func (tg *Target) genMapFieldMarshal(f *protogen.Field) ([]Code, error) {
 	val := f.Message.Fields[1]
 
 	// if the map value is not a message. We don't need to faciliate recursing so
@@ -17,9 +19,14 @@ func (tg *Target) genMapFieldMarshal(f *protogen.Field) (c []Code) {
 		return tg.genBasicFieldMarshal(f)
 	}
 
+	cond, err := tg.marshalPresenceCond(f)
+	if err != nil {
+		return nil, err
+	}
+
 	// for messages we loop over each item and marshal them one by one
 	return []Code{
-		If(tg.marshalPresenceCond(f)...).Block(
+		If(cond...).Block(
 			List(Id("m").Index(Lit(tg.attrName(f))), Err()).Op("=").Qual(tg.idents.ddb, "MarshalMappedMessage").Call(
 				Id("x").Dot(f.GoName),
 				tg.genEmbedOption(f),
@@ -28,15 +35,19 @@ func (tg *Target) genMapFieldMarshal(f *protogen.Field) (c []Code) {
 				Return(Nil(), Qual("fmt", "Errorf").Call(Lit("failed to marshal mapped message field '"+f.GoName+"': %w"), Err())),
 			),
 		),
-	}
-
+	}, nil
 }
 
 // generate nested message marshalling
-func (tg *Target) genMessageFieldMarshal(f *protogen.Field) []Code {
+func (tg *Target) genMessageFieldMarshal(f *protogen.Field) ([]Code, error) {
+	cond, err := tg.marshalPresenceCond(f)
+	if err != nil {
+		return nil, err
+	}
+
 	return []Code{
 		// only marshal message field if the value is not nil at runtime
-		If(tg.marshalPresenceCond(f)...).Block(
+		If(cond...).Block(
 			List(Id(fmt.Sprintf("m%d", f.Desc.Number())), Id("err")).Op(":=").Qual(tg.idents.ddb, "MarshalMessage").Call(
 				Id("x").Dot("Get"+f.GoName).Call(),
 				tg.genEmbedOption(f),
@@ -46,13 +57,18 @@ func (tg *Target) genMessageFieldMarshal(f *protogen.Field) []Code {
 			),
 			Id("m").Index(Lit(tg.attrName(f))).Op("=").Id(fmt.Sprintf("m%d", f.Desc.Number())),
 		),
-	}
+	}, nil
 }
 
 // basic field generates code for marshaling a regular field with basic types
-func (tg *Target) genBasicFieldMarshal(f *protogen.Field) []Code {
+func (tg *Target) genBasicFieldMarshal(f *protogen.Field) ([]Code, error) {
+	cond, err := tg.marshalPresenceCond(f)
+	if err != nil {
+		return nil, err
+	}
+
 	return []Code{
-		If(tg.marshalPresenceCond(f)...).Block(
+		If(cond...).Block(
 			List(
 				Id("m").Index(Lit(tg.attrName(f))),
 				Id("err"),
@@ -65,13 +81,97 @@ func (tg *Target) genBasicFieldMarshal(f *protogen.Field) []Code {
 				Return(Nil(), Qual("fmt", "Errorf").Call(Lit("failed to marshal field '"+f.GoName+"': %w"), Err())),
 			),
 		),
+	}, nil
+}
+
+// validateSetField returns an error if the field is marked as a set, but cannot be stored as one
+func (tg *Target) validateSetField(f *protogen.Field) error {
+	if !tg.isSet(f) {
+		return nil
+	}
+
+	if !f.Desc.IsList() {
+		return fmt.Errorf("field '%s' is marked as a set, but is not a repeated field", f.GoName)
+	}
+
+	switch f.Desc.Kind() {
+	case protoreflect.BoolKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return fmt.Errorf("field '%s' of kind '%s' cannot be a set, only strings, bytes, numbers and enums", f.GoName, f.Desc.Kind())
+	}
+	return nil
+}
+
+// validateCodecField returns an error if the field has a codec, but also options that the codec would
+// have to honor
+func (tg *Target) validateCodecField(f *protogen.Field) error {
+	codec := tg.codecName(f)
+	if codec == "" {
+		return nil
+	}
+
+	isPk, isSk := tg.isKey(f)
+	gsiPk, gsiSk, lsiSk := tg.secondaryIndexNames(f)
+	switch {
+	case tg.isSet(f):
+		return fmt.Errorf("field '%s' has codec '%s', so it cannot also be a set", f.GoName, codec)
+	case tg.isEmbedded(f):
+		return fmt.Errorf("field '%s' has codec '%s', so it cannot also be embedded", f.GoName, codec)
+	case isPk || isSk || len(gsiPk)+len(gsiSk)+len(lsiSk) > 0:
+		return fmt.Errorf("field '%s' has codec '%s', so it cannot be a key", f.GoName, codec)
+	}
+	return nil
+}
+
+// genCodecFieldMarshal generates code that marshals a field with the codec that is registered for it
+func (tg *Target) genCodecFieldMarshal(f *protogen.Field) ([]Code, error) {
+	cond, err := tg.marshalPresenceCond(f)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Code{
+		If(cond...).Block(
+			List(Id("m").Index(Lit(tg.attrName(f))), Err()).Op("=").Qual(tg.idents.ddb, "MarshalCodec").Call(
+				Lit(tg.codecName(f)),
+				Id("x").Dot("Get"+f.GoName).Call(),
+			),
+			If(Err().Op("!=").Nil()).Block(
+				Return(Nil(), Qual("fmt", "Errorf").Call(Lit("failed to marshal field '"+f.GoName+"': %w"), Err())),
+			),
+		),
+	}, nil
+}
+
+// validateAttrNames returns an error for every field that is stored as the same attribute as an earlier
+// field of the message, the marshalled item could only hold one of them.
+func (tg *Target) validateAttrNames(m *protogen.Message) (errs []error) {
+	seen := map[string]*protogen.Field{}
+	for _, field := range m.Fields {
+		if tg.isOmitted(field) {
+			continue // not stored
+		}
+
+		name := tg.attrName(field)
+		if other, ok := seen[name]; ok {
+			errs = append(errs, sourceErrorf(field.Desc,
+				"field '%s' is stored as attribute '%s', which is already the attribute of field '%s'",
+				field.GoName, name, other.GoName))
+			continue
+		}
+		seen[name] = field
 	}
+	return errs
 }
 
 // genSetFieldMarshal generates code to marshal a field into a StringSet, NumberSet or BinarySet
-func (tg *Target) genSetFieldMarshal(f *protogen.Field) []Code {
+func (tg *Target) genSetFieldMarshal(f *protogen.Field) ([]Code, error) {
+	cond, err := tg.marshalPresenceCond(f)
+	if err != nil {
+		return nil, err
+	}
+
 	return []Code{
-		If(tg.marshalPresenceCond(f)...).Block(
+		If(cond...).Block(
 			List(Id("m").Index(Lit(tg.attrName(f))), Err()).Op("=").Qual(tg.idents.ddb, "MarshalSet").Call(
 				Id("x").Dot(f.GoName),
 				tg.genEmbedOption(f),
@@ -80,11 +180,11 @@ func (tg *Target) genSetFieldMarshal(f *protogen.Field) []Code {
 				Return(Nil(), Qual("fmt", "Errorf").Call(Lit("failed to marshal set item of field '"+f.GoName+"': %w"), Err())),
 			),
 		),
-	}
+	}, nil
 }
 
 // genListFieldMarshal generates marshal code for a repeated field
-func (tg *Target) genListFieldMarshal(f *protogen.Field) []Code {
+func (tg *Target) genListFieldMarshal(f *protogen.Field) ([]Code, error) {
 
 	// if its not a list of messages, it could be marked as as a set
 	if f.Message == nil && tg.isSet(f) {
@@ -94,10 +194,15 @@ func (tg *Target) genListFieldMarshal(f *protogen.Field) []Code {
 		return tg.genBasicFieldMarshal(f)
 	}
 
+	cond, err := tg.marshalPresenceCond(f)
+	if err != nil {
+		return nil, err
+	}
+
 	// for messages we loop over each item and marshal them one by one
 	return []Code{
 		// only marshal if its not the zero value
-		If(tg.marshalPresenceCond(f)...).Block(
+		If(cond...).Block(
 			List(Id("m").Index(Lit(tg.attrName(f))), Err()).Op("=").Qual(tg.idents.ddb, "MarshalRepeatedMessage").Call(
 				Id("x").Dot(f.GoName),
 				tg.genEmbedOption(f),
@@ -106,6 +211,34 @@ func (tg *Target) genListFieldMarshal(f *protogen.Field) []Code {
 				Return(Nil(), Qual("fmt", "Errorf").Call(Lit("failed to marshal repeated message field '"+f.GoName+"': %w"), Err())),
 			),
 		),
+	}, nil
+}
+
+// genFieldMarshal validates a field and generates marshal code for it, depending on its kind
+func (tg *Target) genFieldMarshal(field *protogen.Field) ([]Code, error) {
+	if err := tg.validateSetField(field); err != nil {
+		return nil, err
+	}
+	if err := tg.validateCodecField(field); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case tg.codecName(field) != "":
+		// fields with a codec are marshalled by it, whatever their kind
+		return tg.genCodecFieldMarshal(field)
+	case field.Desc.IsList():
+		// lists are repeated fields
+		return tg.genListFieldMarshal(field)
+	case field.Desc.IsMap():
+		// field map is technically a message but we marshal it differently
+		return tg.genMapFieldMarshal(field)
+	case field.Message != nil:
+		// nested message, not part of a one-of
+		return tg.genMessageFieldMarshal(field)
+	default:
+		// else, assume basic marshalling can handle it
+		return tg.genBasicFieldMarshal(field)
 	}
 }
 
@@ -114,6 +247,7 @@ func (tg *Target) genMessageMarshal(f *File, m *protogen.Message) error {
 
 	// render method body
 	body := []Code{Id("m").Op("=").Make(Map(String()).Qual(types, "AttributeValue"))}
+	errs := tg.validateAttrNames(m)
 
 	// generate field marshalling code
 	for _, field := range m.Fields {
@@ -121,20 +255,16 @@ func (tg *Target) genMessageMarshal(f *File, m *protogen.Message) error {
 			continue // generate no marshallling code for omitted fields
 		}
 
-		switch {
-		case field.Desc.IsList():
-			// lists are repeated fields
-			body = append(body, tg.genListFieldMarshal(field)...)
-		case field.Desc.IsMap():
-			// field map is technically a message but we marshal it differently
-			body = append(body, tg.genMapFieldMarshal(field)...)
-		case field.Message != nil:
-			// nested message, not part of a one-of
-			body = append(body, tg.genMessageFieldMarshal(field)...)
-		default:
-			// else, assume basic marshalling can handle it
-			body = append(body, tg.genBasicFieldMarshal(field)...)
+		c, err := tg.genFieldMarshal(field)
+		if err != nil {
+			errs = append(errs, sourceError(field.Desc, err))
+			continue
 		}
+		body = append(body, c...)
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
 	}
 
 	body = append(body,
@@ -149,5 +279,19 @@ func (tg *Target) genMessageMarshal(f *File, m *protogen.Message) error {
 			Id("m").Map(String()).Qual(types, "AttributeValue"),
 			Id("err").Id("error"),
 		).Block(body...)
+
+	// render function that estimates the item size from the marshalled item
+	f.Comment(`DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB`)
+	f.Func().
+		Params(Id("x").Op("*").Id(m.GoIdent.GoName)).Id("DynamoItemSize").
+		Params().
+		Params(Int(), Error()).
+		Block(
+			List(Id("m"), Err()).Op(":=").Id("x").Dot("MarshalDynamoItem").Call(),
+			If(Err().Op("!=").Nil()).Block(
+				Return(Lit(0), Qual("fmt", "Errorf").Call(Lit("failed to marshal item: %w"), Err())),
+			),
+			Return(Qual(tg.idents.ddb, "ItemSize").Call(Id("m")), Nil()),
+		)
 	return nil
 }
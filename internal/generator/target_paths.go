@@ -1,21 +1,460 @@
 package generator
 
 import (
+	"errors"
 	"fmt"
+	"path"
+	"strings"
+	"unicode"
 
+	ddbv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
 	. "github.com/dave/jennifer/jen"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
+// typedPathType returns the typed path for a field of basic type, or nil if it has no typed path (e.g:
+// messages that don't support path building)
+func (tg *Target) typedPathType(field *protogen.Field) *Statement {
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		return Qual(tg.idents.ddbpath, "String")
+	case protoreflect.BytesKind:
+		return Qual(tg.idents.ddbpath, "Bytes")
+	case protoreflect.BoolKind:
+		return Qual(tg.idents.ddbpath, "Bool")
+	case protoreflect.EnumKind:
+		return Qual(tg.idents.ddbpath, "Enum").Types(tg.enumValueType(field))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return Qual(tg.idents.ddbpath, "Number").Types(Int32())
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return Qual(tg.idents.ddbpath, "Number").Types(Int64())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return Qual(tg.idents.ddbpath, "Number").Types(Uint32())
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return Qual(tg.idents.ddbpath, "Number").Types(Uint64())
+	case protoreflect.FloatKind:
+		return Qual(tg.idents.ddbpath, "Number").Types(Float32())
+	case protoreflect.DoubleKind:
+		return Qual(tg.idents.ddbpath, "Number").Types(Float64())
+	default:
+		return nil
+	}
+}
+
+// pathValueType returns the Go type of the values that typed paths accept for a basic type field
+func (tg *Target) pathValueType(field *protogen.Field) (*Statement, error) {
+	if field.Desc.Kind() == protoreflect.EnumKind {
+		return tg.enumValueType(field), nil
+	}
+	return tg.fieldGoType(field)
+}
+
+// enumValueType returns the type that typed paths accept for an enum field. The message package imports
+// its path package, so enums of the package we generate for can only be accepted as any enum value.
+func (tg *Target) enumValueType(field *protogen.Field) *Statement {
+	if tg.isSamePkgIdent(field.Enum.GoIdent) {
+		return Qual("google.golang.org/protobuf/reflect/protoreflect", "Enum")
+	}
+	return Qual(string(field.Enum.GoIdent.GoImportPath), field.Enum.GoIdent.GoName)
+}
+
+// genEmbeddedFieldPath generates the path method for a field that is stored with an embedded encoding,
+// its value cannot be selected into or compared but it can be updated as a whole.
+func (tg *Target) genEmbeddedFieldPath(f *File, m *protogen.Message, field *protogen.Field) error {
+	f.Commentf("%s returns 'p' with the attribute name appended and allow updating the embedded value", field.GoName)
+	f.Func().
+		Params(Id("p").Add(tg.pathStructType(m))).Id(field.GoName).
+		Params().
+		Params(Qual(tg.idents.ddbpath, "Embedded")).
+		Block(
+			Return(tg.embeddedPath(field,
+				Id("p").Dot("AppendName").Call(Qual(expression, "Name").Call(Lit(tg.attrName(field)))))),
+		)
+
+	return nil
+}
+
+// genCodedFieldPath generates the path method for a field that is stored by a codec, its value cannot be
+// selected into or compared but it can be updated as a whole.
+func (tg *Target) genCodedFieldPath(f *File, m *protogen.Message, field *protogen.Field) error {
+	vtyp, err := tg.codedValueType(field)
+	if err != nil {
+		return err
+	}
+
+	f.Commentf("%s returns 'p' with the attribute name appended and allow updating the value with its codec", field.GoName)
+	f.Func().
+		Params(Id("p").Add(tg.pathStructType(m))).Id(field.GoName).
+		Params().
+		Params(Qual(tg.idents.ddbpath, "Coded").Types(vtyp)).
+		Block(
+			Return(Qual(tg.idents.ddbpath, "NewCoded").Types(vtyp).Call(
+				Id("p").Dot("AppendName").Call(Qual(expression, "Name").Call(Lit(tg.attrName(field)))),
+				Lit(tg.codecName(field)),
+			)),
+		)
+
+	return nil
+}
+
+// codedValueType returns the type of the values that the path of a codec field accepts, the Go type of
+// the field. Messages of the package we generate for cannot be named by the path package, so they are
+// accepted as proto.Message, or as any value when in a list or map.
+func (tg *Target) codedValueType(field *protogen.Field) (*Statement, error) {
+	elem := func(f *protogen.Field) (*Statement, error) {
+		switch {
+		case f.Message != nil && tg.isSamePkgIdent(f.Message.GoIdent):
+			return Qual("google.golang.org/protobuf/proto", "Message"), nil
+		case f.Message != nil:
+			return Op("*").Qual(string(f.Message.GoIdent.GoImportPath), f.Message.GoIdent.GoName), nil
+		default:
+			return tg.pathValueType(f)
+		}
+	}
+
+	switch {
+	case field.Desc.IsMap():
+		key, val := field.Message.Fields[0], field.Message.Fields[1]
+		if val.Message != nil && tg.isSamePkgIdent(val.Message.GoIdent) {
+			return Any(), nil
+		}
+		ktyp, err := elem(key)
+		if err != nil {
+			return nil, err
+		}
+		vtyp, err := elem(val)
+		if err != nil {
+			return nil, err
+		}
+		return Map(ktyp).Add(vtyp), nil
+	case field.Desc.IsList():
+		if field.Message != nil && tg.isSamePkgIdent(field.Message.GoIdent) {
+			return Any(), nil
+		}
+		vtyp, err := elem(field)
+		if err != nil {
+			return nil, err
+		}
+		return Index().Add(vtyp), nil
+	default:
+		return elem(field)
+	}
+}
+
+// genOneofPaths generates a path group for the members of a oneof, and the path methods of the members.
+// Members are stored as sibling attributes so setting one member also removes the others.
+func (tg *Target) genOneofPaths(f *File, m *protogen.Message, oneof *protogen.Oneof) error {
+	var members []*protogen.Field
+	for _, field := range oneof.Fields {
+		if !tg.isOmitted(field) {
+			members = append(members, field)
+		}
+	}
+	if len(members) < 1 {
+		return nil
+	}
+
+	name := m.GoIdent.GoName + oneof.GoName + "OneofPath"
+	f.Commentf("%s groups the paths of the members of oneof '%s'", name, oneof.Desc.Name())
+	f.Type().Id(name).Struct(Qual(expression, "NameBuilder"))
+
+	f.Commentf("%s returns the path group of the oneof members that sets one member at a time", oneof.GoName)
+	f.Func().Params(Id("p").Add(tg.pathStructType(m))).Id(oneof.GoName).
+		Params().
+		Params(Id(name)).
+		Block(Return(Id(name).Values(Dict{Id("NameBuilder"): Id("p").Dot("NameBuilder")})))
+
+	var errs []error
+	for _, field := range members {
+		memberName := func(p *Statement, f *protogen.Field) Code {
+			return Add(p).Dot("AppendName").Call(Qual(expression, "Name").Call(Lit(tg.attrName(f))))
+		}
+
+		var others []Code
+		for _, other := range members {
+			if other != field {
+				others = append(others, memberName(Id("p"), other))
+			}
+		}
+
+		// the value type and update for setting the member, honoring embed encoding
+		var vtyp, upd *Statement
+		var err error
+		switch {
+		case tg.codecName(field) != "":
+			if vtyp, err = tg.codedValueType(field); err != nil {
+				return sourceError(field.Desc, err)
+			}
+			upd = Qual(tg.idents.ddbpath, "NewCoded").Types(vtyp).Call(memberName(Id("p"), field), Lit(tg.codecName(field))).Dot("Set").Call(Id("v"))
+		case tg.isEmbedded(field):
+			if vtyp, err = tg.embeddedValueType(field); err != nil {
+				return sourceError(field.Desc, err)
+			}
+			upd = tg.embeddedPath(field, memberName(Id("p"), field)).Dot("Set").Call(Id("v"))
+		case field.Message != nil:
+			vtyp = Qual("google.golang.org/protobuf/proto", "Message")
+			upd = Qual(tg.idents.ddbpath, "SetMessage").Call(memberName(Id("p"), field), Lit(string(field.Message.Desc.FullName())), Id("v"))
+		default:
+			if vtyp, err = tg.pathValueType(field); err != nil {
+				return sourceError(field.Desc, err)
+			}
+			upd = Add(tg.typedPathType(field)).Values().Dot("WithDynamoNameBuilder").Call(memberName(Id("p"), field)).Dot("Set").Call(Id("v"))
+		}
+
+		f.Commentf("Set%s returns an update that sets member '%s' to 'v' and removes the other members",
+			field.GoName, field.Desc.Name())
+		f.Func().Params(Id("p").Id(name)).Id("Set" + field.GoName).
+			Params(Id("v").Add(vtyp)).
+			Params(Qual(tg.idents.ddbpath, "Update")).
+			Block(Return(Qual(tg.idents.ddbpath, "SetOneof").Call(append([]Code{upd}, others...)...)))
+
+		f.Commentf("Which%s returns a condition that checks if '%s' is the member of the oneof that is set",
+			field.GoName, field.Desc.Name())
+		f.Func().Params(Id("p").Id(name)).Id("Which" + field.GoName).
+			Params().
+			Params(Qual(expression, "ConditionBuilder")).
+			Block(Return(Qual(tg.idents.ddbpath, "WhichOneof").Call(append([]Code{memberName(Id("p"), field)}, others...)...)))
+
+		// members whose path can't update the attribute get the same path method as other fields
+		mp, err := tg.oneofMemberPath(field)
+		if err != nil {
+			errs = append(errs, sourceError(field.Desc, err))
+			continue
+		} else if mp == nil {
+			errs = append(errs, sourceError(field.Desc, tg.genFieldPath(f, m, field)))
+			continue
+		}
+
+		// other members' paths hang off the group that the member path holds
+		others = others[:0]
+		for _, other := range members {
+			if other != field {
+				others = append(others, memberName(Id("p").Dot("oneof"), other))
+			}
+		}
+
+		mname := m.GoIdent.GoName + field.GoName + "MemberPath"
+		f.Commentf("%s is the path to member '%s' of oneof '%s', its updates remove the other members",
+			mname, field.Desc.Name(), oneof.Desc.Name())
+		if mp.whole {
+			f.Comment("Nested fields can't be selected since their updates wouldn't remove the other members, the")
+			f.Comment("member is updated as a whole, or through the oneof's Set method.")
+		}
+		f.Type().Id(mname).Struct(
+			mp.typ,
+			Id("oneof").Id(name),
+		)
+
+		f.Commentf("%s returns 'p' with the attribute name appended and allow updating it as a member of oneof '%s'",
+			field.GoName, oneof.Desc.Name())
+		f.Func().Params(Id("p").Add(tg.pathStructType(m))).Id(field.GoName).
+			Params().
+			Params(Id(mname)).
+			Block(Return(Id(mname).Values(Dict{
+				Id(mp.embedded): mp.init(memberName(Id("p"), field)),
+				Id("oneof"):     Id("p").Dot(oneof.GoName).Call(),
+			})))
+
+		for _, setter := range mp.setters {
+			f.Commentf("%s returns the update of the member's path, that also removes the other members", setter)
+			f.Func().Params(Id("p").Id(mname)).Id(setter).
+				Params(Id("v").Add(mp.vtyp)).
+				Params(Qual(tg.idents.ddbpath, "Update")).
+				Block(Return(Qual(tg.idents.ddbpath, "SetOneof").Call(
+					append([]Code{mp.update(Id("p").Dot(mp.embedded), setter)}, others...)...)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// memberPath describes the path of a oneof member whose updates must also remove the other members
+type memberPath struct {
+	typ      *Statement               // type of the member's path
+	embedded string                   // name of the path when embedded
+	init     func(nb Code) *Statement // inits the member's path from its name builder
+	vtyp     *Statement               // type of the value that the setters take
+	setters  []string                 // methods of the path that update the attribute
+
+	// whole is set when the member can only be updated as a whole, with 'set' building the updates
+	whole bool
+	set   func(p *Statement, setter string) *Statement
+}
+
+// update returns the update of 'setter' with value 'v' on the member's path 'p'
+func (mp memberPath) update(p *Statement, setter string) *Statement {
+	if mp.set != nil {
+		return mp.set(p, setter)
+	}
+	return p.Dot(setter).Call(Id("v"))
+}
+
+// oneofMemberPath returns the path of a oneof member that can update the attribute, or nil if the path
+// of the member cannot update it.
+func (tg *Target) oneofMemberPath(field *protogen.Field) (*memberPath, error) {
+	withName := func(typ *Statement) func(nb Code) *Statement {
+		return func(nb Code) *Statement { return Add(typ).Values().Dot("WithDynamoNameBuilder").Call(nb) }
+	}
+
+	switch {
+	case tg.codecName(field) != "":
+		vtyp, err := tg.codedValueType(field)
+		if err != nil {
+			return nil, err
+		}
+		return &memberPath{
+			typ:      Qual(tg.idents.ddbpath, "Coded").Types(vtyp),
+			embedded: "Coded",
+			init: func(nb Code) *Statement {
+				return Qual(tg.idents.ddbpath, "NewCoded").Types(vtyp).Call(nb, Lit(tg.codecName(field)))
+			},
+			vtyp:    vtyp,
+			setters: []string{"Set", "SetIfNotExists"},
+		}, nil
+	case tg.isEmbedded(field):
+		vtyp, err := tg.embeddedValueType(field)
+		if err != nil {
+			return nil, err
+		}
+		return &memberPath{
+			typ:      Qual(tg.idents.ddbpath, "Embedded"),
+			embedded: "Embedded",
+			init:     func(nb Code) *Statement { return tg.embeddedPath(field, nb) },
+			vtyp:     vtyp,
+			setters:  []string{"Set", "SetIfNotExists"},
+		}, nil
+	case field.Message != nil && tg.isWellKnownScalar(field.Message):
+		name := wellKnownPaths[field.Message.Desc.FullName()]
+		return &memberPath{
+			typ:      Qual(tg.idents.ddbpath, name),
+			embedded: name,
+			init:     withName(Qual(tg.idents.ddbpath, name)),
+			vtyp:     wellKnownScalarValueTypes[field.Message.Desc.FullName()],
+			setters:  []string{"Set", "SetIfNotExists"},
+		}, nil
+	case field.Message != nil && !tg.notSupportPathing(field) && !tg.isWellKnownPathSupported(field.Message):
+		// the message's path is not embedded: updates of its nested fields wouldn't remove the other members
+		setters := map[string]string{"DynamoSet": "SetMessage", "DynamoSetIfNotExists": "SetMessageIfNotExists"}
+		return &memberPath{
+			typ:      Qual(expression, "NameBuilder"),
+			embedded: "NameBuilder",
+			init:     func(nb Code) *Statement { return Add(nb) },
+			vtyp:     Qual("google.golang.org/protobuf/proto", "Message"),
+			setters:  []string{"DynamoSet", "DynamoSetIfNotExists"},
+			whole:    true,
+			set: func(p *Statement, setter string) *Statement {
+				return Qual(tg.idents.ddbpath, setters[setter]).Call(
+					p, Lit(string(field.Message.Desc.FullName())), Id("v"))
+			},
+		}, nil
+	case field.Message != nil:
+		return nil, nil // paths of well-knowns that are not scalars, or without path support, don't update
+	}
+
+	vtyp, err := tg.pathValueType(field)
+	if err != nil {
+		return nil, err
+	}
+
+	mp := &memberPath{
+		typ:     tg.typedPathType(field),
+		init:    withName(tg.typedPathType(field)),
+		vtyp:    vtyp,
+		setters: []string{"Set", "SetIfNotExists"},
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		mp.embedded = "String"
+	case protoreflect.BytesKind:
+		mp.embedded = "Bytes"
+	case protoreflect.BoolKind:
+		mp.embedded = "Bool"
+	case protoreflect.EnumKind:
+		mp.embedded = "Enum"
+	default:
+		mp.embedded, mp.setters = "Number", append(mp.setters, "Add")
+	}
+	return mp, nil
+}
+
+// wellKnownScalarValueTypes maps the well-known messages that are stored as scalars to the type of value
+// that their path is updated with
+var wellKnownScalarValueTypes = map[protoreflect.FullName]*Statement{
+	"google.protobuf.Timestamp":   Qual("time", "Time"),
+	"google.protobuf.Duration":    Qual("time", "Duration"),
+	"google.protobuf.StringValue": String(),
+	"google.protobuf.BoolValue":   Bool(),
+	"google.protobuf.BytesValue":  Index().Byte(),
+	"google.protobuf.DoubleValue": Float64(),
+	"google.protobuf.FloatValue":  Float32(),
+	"google.protobuf.Int32Value":  Int32(),
+	"google.protobuf.Int64Value":  Int64(),
+	"google.protobuf.UInt32Value": Uint32(),
+	"google.protobuf.UInt64Value": Uint64(),
+}
+
+// embeddedValueType returns the type of value that the path of an embedded field is updated with
+func (tg *Target) embeddedValueType(field *protogen.Field) (*Statement, error) {
+	if field.Message != nil && !field.Desc.IsList() && !field.Desc.IsMap() {
+		return Qual("google.golang.org/protobuf/proto", "Message"), nil
+	}
+	return tg.pathValueType(field)
+}
+
+// embeddedPath returns the statement that inits the path of an embedded field from name builder 'nb',
+// embedded messages are checked to be of the field's message type.
+func (tg *Target) embeddedPath(field *protogen.Field, nb Code) *Statement {
+	enc := Qual(tg.idents.ddbv1, "Encoding_"+ddbv1.Encoding_name[int32(tg.embedEncoding(field))])
+	if field.Message != nil && !field.Desc.IsList() && !field.Desc.IsMap() {
+		return Qual(tg.idents.ddbpath, "NewEmbeddedMessage").Call(nb, enc, Lit(string(field.Message.Desc.FullName())))
+	}
+	return Qual(tg.idents.ddbpath, "NewEmbedded").Call(nb, enc)
+}
+
+// genFieldPath generates the path method of a field, depending on how the field is stored
+func (tg *Target) genFieldPath(f *File, m *protogen.Message, field *protogen.Field) error {
+	switch {
+	case tg.codecName(field) != "":
+		return tg.genCodedFieldPath(f, m, field)
+	case tg.isEmbedded(field):
+		return tg.genEmbeddedFieldPath(f, m, field)
+	case field.Desc.IsList():
+		return tg.genListFieldPath(f, m, field)
+	case field.Desc.IsMap():
+		return tg.genMapFieldPath(f, m, field)
+	case field.Message != nil:
+		return tg.genMessageFieldPath(f, m, field)
+	default:
+		return tg.genBasicFieldPath(f, m, field)
+	}
+}
+
 // genBasicFieldPath implements the generation of method for building baths for basic type fields
 func (tg *Target) genBasicFieldPath(f *File, m *protogen.Message, field *protogen.Field) error {
-	f.Commentf("%s appends the path being build", field.GoName)
+
+	// fields that are not of a basic type (e.g: embedded messages) end the path without typed conditions
+	typ := tg.typedPathType(field)
+	if typ == nil {
+		f.Commentf("%s appends the path being build", field.GoName)
+		f.Func().
+			Params(Id("p").Add(tg.pathStructType(m))).Id(field.GoName).
+			Params().
+			Params(Qual(expression, "NameBuilder")).
+			Block(
+				Return(Id("p").Dot("AppendName").Call(Qual(expression, "Name").Call(Lit(tg.attrName(field))))),
+			)
+		return nil
+	}
+
+	f.Commentf("%s returns 'p' with the attribute name appended and allow typed conditions on the value", field.GoName)
 	f.Func().
 		Params(Id("p").Add(tg.pathStructType(m))).Id(field.GoName).
 		Params().
-		Params(Qual(expression, "NameBuilder")).
+		Params(typ).
 		Block(
-			Return(Id("p").Dot("AppendName").Call(Qual(expression, "Name").Call(Lit(tg.attrName(field))))),
+			Return(Add(typ).Values().Dot("WithDynamoNameBuilder").Call(
+				Id("p").Dot("AppendName").Call(Qual(expression, "Name").Call(Lit(tg.attrName(field)))))),
 		)
 
 	return nil
@@ -24,19 +463,23 @@ func (tg *Target) genBasicFieldPath(f *File, m *protogen.Message, field *protoge
 // genMessageFieldPath implements the generation of method for building baths for message type fields
 func (tg *Target) genMessageFieldPath(f *File, m *protogen.Message, field *protogen.Field) error {
 
-	// don't generate recursive path methods if it is an external message, and not embedded
+	// don't generate recursive path methods if the message has no path building, or it is embedded
 	if tg.notSupportPathing(field) {
 		return tg.genBasicFieldPath(f, m, field) // NOTE: may support traversing of this in the future
 	}
 
 	// generate the method that append the path element
-	f.Commentf("%s returns 'p' with the attribute name appended and allow subselecting nested message", field.GoName)
+	if tg.isWellKnownScalar(field.Message) {
+		f.Commentf("%s returns 'p' with the attribute name appended and allow typed conditions on the value", field.GoName)
+	} else {
+		f.Commentf("%s returns 'p' with the attribute name appended and allow subselecting nested message", field.GoName)
+	}
 	f.Func().
 		Params(Id("p").Add(tg.pathStructType(m))).Id(field.GoName).
 		Params().
-		Params(tg.pathStructType(field.Message)).
+		Params(tg.fieldPathStructType(field.Message)).
 		Block(
-			Return(tg.pathStructType(field.Message).Values(Dict{
+			Return(tg.fieldPathStructType(field.Message).Values(Dict{
 				Id("NameBuilder"): Id("p").Dot("AppendName").Call(Qual(expression, "Name").Call(Lit(tg.attrName(field)))),
 			})),
 		)
@@ -47,9 +490,45 @@ func (tg *Target) genMessageFieldPath(f *File, m *protogen.Message, field *proto
 // genListFieldPath implements the generation of method for building baths for message type fields
 func (tg *Target) genListFieldPath(f *File, m *protogen.Message, field *protogen.Field) error {
 
-	// if it's a list of basic types, or the repeated message is not in the same package and not well-known.
-	// Or if the message is a embedded, then it is also a basic path
-	if tg.notSupportPathing(field) {
+	// if it's a list of basic types, or the repeated message has no generated path building (e.g: not a
+	// supported well-known). Or if the message is a embedded, then it is also a basic path
+	if typ := tg.typedPathType(field); typ != nil {
+
+		// sets allow adding and deleting items, lists allow appending. Strings sets and lists can
+		// also be checked for containing a string.
+		vtyp, err := tg.pathValueType(field)
+		if err != nil {
+			return err
+		}
+
+		var lt *Statement
+		switch {
+		case tg.isSet(field) && field.Desc.Kind() == protoreflect.EnumKind:
+			lt = Qual(tg.idents.ddbpath, "EnumSet").Types(vtyp)
+		case tg.isSet(field) && field.Desc.Kind() == protoreflect.StringKind:
+			lt = Qual(tg.idents.ddbpath, "StringSet")
+		case tg.isSet(field):
+			lt = Qual(tg.idents.ddbpath, "ValueSet").Types(vtyp)
+		case field.Desc.Kind() == protoreflect.StringKind:
+			lt = Qual(tg.idents.ddbpath, "StringList")
+		default:
+			lt = Qual(tg.idents.ddbpath, "ValueList").Types(typ, vtyp)
+		}
+
+		if tg.isSet(field) {
+			f.Commentf("%s returns 'p' appended with the attribute name and allow updating the set", field.GoName)
+		} else {
+			f.Commentf("%s returns 'p' appended with the attribute name and allow indexing typed values", field.GoName)
+		}
+		f.Func().Params(Id("p").Add(tg.pathStructType(m))).Id(field.GoName).
+			Params().
+			Params(lt).
+			Block(
+				Return(Add(lt).Values().Dot("WithDynamoNameBuilder").Call(
+					Id("p").Dot("AppendName").Call(Qual(expression, "Name").Call(Lit(tg.attrName(field)))))),
+			)
+		return nil
+	} else if tg.notSupportPathing(field) {
 
 		// If its not a list of messages, the path will always end and the generated method will return
 		// basic path builder that always returns a string with the final path
@@ -67,8 +546,8 @@ func (tg *Target) genListFieldPath(f *File, m *protogen.Message, field *protogen
 		return nil
 	}
 
-	// else, we assume its a message in the same package
-	got := tg.pathStructType(field.Message)
+	// else, it's a message with generated path building in this or another package
+	got := tg.fieldPathStructType(field.Message)
 	f.Commentf("%s returns 'p' appended with the attribute while allow indexing a nested message", field.GoName)
 	f.Func().Params(Id("p").Add(tg.pathStructType(m))).Id(field.GoName).
 		Params().
@@ -86,9 +565,20 @@ func (tg *Target) genListFieldPath(f *File, m *protogen.Message, field *protogen
 func (tg *Target) genMapFieldPath(f *File, m *protogen.Message, field *protogen.Field) error {
 	val := field.Message.Fields[1] // value type of the message
 
-	// if it's a map of basic types, or the repeated message is not in the same package we return a
-	// a basic list accessor. NOTE: we maybe support external messages in the future
-	if tg.notSupportPathing(val) {
+	// if it's a map of basic types, or the message value has no generated path building we return a
+	// a basic map accessor.
+	if typ := tg.typedPathType(val); typ != nil {
+		f.Commentf("%s returns 'p' appended with the attribute name and allow map keys of typed values", field.GoName)
+		f.Func().Params(Id("p").Add(tg.pathStructType(m))).Id(field.GoName).
+			Params().
+			Params(Qual(tg.idents.ddbpath, "ItemMap").Types(typ)).
+			Block(
+				Return(Qual(tg.idents.ddbpath, "ItemMap").Types(typ).Values(Dict{
+					Id("NameBuilder"): Id("p").Dot("AppendName").Call(Qual(expression, "Name").Call(Lit(tg.attrName(field)))),
+				})),
+			)
+		return nil
+	} else if tg.notSupportPathing(val) {
 		f.Commentf("%s returns 'p' appended with the attribute name and allow map keys to be specified", field.GoName)
 		f.Func().Params(Id("p").Add(tg.pathStructType(m))).Id(field.GoName).
 			Params().
@@ -103,8 +593,8 @@ func (tg *Target) genMapFieldPath(f *File, m *protogen.Message, field *protogen.
 		return nil
 	}
 
-	// else, we assume its a message in the same package
-	got := tg.pathStructType(val.Message)
+	// else, it's a message with generated path building in this or another package
+	got := tg.fieldPathStructType(val.Message)
 	f.Commentf("%s returns 'p' appended with the attribute while allow map keys on a nested message", field.GoName)
 	f.Func().Params(Id("p").Add(tg.pathStructType(m))).Id(field.GoName).
 		Params().
@@ -118,66 +608,263 @@ func (tg *Target) genMapFieldPath(f *File, m *protogen.Message, field *protogen.
 	return nil
 }
 
+// importPathPackages names the imports of path packages that are generated for other packages, and of
+// the packages that declare the enums that typed paths accept.
+func (tg *Target) importPathPackages(f *File, pkgSuffix string) {
+	for _, m := range tg.src.Messages {
+		for _, field := range m.Fields {
+			if field.Desc.IsMap() {
+				field = field.Message.Fields[1]
+			}
+			if field.Enum != nil && !tg.isSamePkgIdent(field.Enum.GoIdent) {
+				f.ImportName(string(field.Enum.GoIdent.GoImportPath), goPackageName(field.Enum.Desc.ParentFile()))
+			}
+			if tg.notSupportPathing(field) || tg.isSamePkgIdent(field.Message.GoIdent) ||
+				tg.isWellKnownPathSupported(field.Message) {
+				continue
+			}
+
+			f.ImportName(
+				path.Join(string(field.Message.GoIdent.GoImportPath), "ddbpath"),
+				goPackageName(field.Message.Desc.ParentFile())+pkgSuffix)
+		}
+	}
+}
+
+// goPackageName determines the Go package name of a file the same way protoc-gen-go does
+func goPackageName(fd protoreflect.FileDescriptor) string {
+	gopkg := fd.Options().(*descriptorpb.FileOptions).GetGoPackage()
+	if i := strings.LastIndex(gopkg, ";"); i >= 0 {
+		return gopkg[i+1:]
+	}
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, path.Base(gopkg))
+}
+
 // path struct type name
 func (tg *Target) pathStructIdentName(m *protogen.Message) string {
 	return m.GoIdent.GoName + "Path"
 }
 
+// wellKnownPaths maps well-known messages to the path struct in the ddbpath package that supports them
+var wellKnownPaths = map[protoreflect.FullName]string{
+	"google.protobuf.Any":         "AnyPath",
+	"google.protobuf.Value":       "ValuePath",
+	"google.protobuf.Struct":      "StructPath",
+	"google.protobuf.ListValue":   "ListValuePath",
+	"google.protobuf.FieldMask":   "FieldMaskPath",
+	"google.protobuf.Timestamp":   "TimestampPath",
+	"google.protobuf.Duration":    "DurationPath",
+	"google.protobuf.StringValue": "StringValuePath",
+	"google.protobuf.BoolValue":   "BoolValuePath",
+	"google.protobuf.BytesValue":  "BytesValuePath",
+	"google.protobuf.DoubleValue": "DoubleValuePath",
+	"google.protobuf.FloatValue":  "FloatValuePath",
+	"google.protobuf.Int32Value":  "Int32ValuePath",
+	"google.protobuf.Int64Value":  "Int64ValuePath",
+	"google.protobuf.UInt32Value": "UInt32ValuePath",
+	"google.protobuf.UInt64Value": "UInt64ValuePath",
+}
+
+// wellKnownPath returns the path struct of a well-known message, or of a message that is configured to
+// be a well-known through the 'well_known_path' option.
+func (tg *Target) wellKnownPath(m *protogen.Message) (*Statement, bool) {
+	if name, ok := wellKnownPaths[m.Desc.FullName()]; ok {
+		return Qual(tg.idents.ddbpath, name), true
+	}
+	if ident, ok := tg.wellKnownPaths[m.Desc.FullName()]; ok {
+		return Qual(string(ident.GoImportPath), ident.GoName), true
+	}
+	return nil, false
+}
+
+// isConfiguredWellKnown returns whether the message is configured to be a well-known through the
+// 'well_known_path' option, its encoding is registered at runtime so its attribute type is not known.
+func (tg *Target) isConfiguredWellKnown(m *protogen.Message) bool {
+	_, ok := tg.wellKnownPaths[m.Desc.FullName()]
+	return ok
+}
+
 // isWellKnownPathSupported returns true if a message is a well-known message and we support
 // generating type-safe path accessors for it
 func (tg *Target) isWellKnownPathSupported(m *protogen.Message) bool {
-	switch m.GoIdent.String() {
-	case `"\"google.golang.org/protobuf/types/known/anypb\"".Any`:
-		return true
-	case `"\"google.golang.org/protobuf/types/known/structpb\"".Value`:
-		return true
-	case `"\"google.golang.org/protobuf/types/known/fieldmaskpb\"".FieldMask`:
-		return true
-	}
+	_, ok := tg.wellKnownPath(m)
+	return ok
+}
 
+// isWellKnownScalar returns true if the well-known message is stored as a scalar attribute, so paths
+// cannot select into it
+func (tg *Target) isWellKnownScalar(m *protogen.Message) bool {
+	switch m.GoIdent.GoImportPath {
+	case "google.golang.org/protobuf/types/known/timestamppb",
+		"google.golang.org/protobuf/types/known/durationpb",
+		"google.golang.org/protobuf/types/known/wrapperspb":
+		return tg.isWellKnownPathSupported(m)
+	}
 	return false
 }
 
+// fieldPathStructType returns the path struct for fields that hold message 'm', which is the path struct
+// of a well-known if 'm' is one.
+func (tg *Target) fieldPathStructType(m *protogen.Message) *Statement {
+	if typ, ok := tg.wellKnownPath(m); ok {
+		return typ
+	}
+	return tg.pathStructType(m)
+}
+
 // pathStructType returns an identifier or qualifier statement for a path struct.
 func (tg *Target) pathStructType(m *protogen.Message) *Statement {
-	switch m.GoIdent.String() {
-	case `"\"google.golang.org/protobuf/types/known/anypb\"".Any`:
-		return Qual(tg.idents.ddbpath, "AnyPath")
-	case `"\"google.golang.org/protobuf/types/known/structpb\"".Value`:
-		return Qual(tg.idents.ddbpath, "ValuePath")
-	case `"\"google.golang.org/protobuf/types/known/fieldmaskpb\"".FieldMask`:
-		return Qual(tg.idents.ddbpath, "FieldMaskPath")
+	if name, ok := wellKnownPaths[m.Desc.FullName()]; ok {
+		return Qual(tg.idents.ddbpath, name)
+	}
+
+	// messages from other packages refer to the path struct in their generated ddbpath sub-package
+	if !tg.isSamePkgIdent(m.GoIdent) {
+		return Qual(path.Join(string(m.GoIdent.GoImportPath), "ddbpath"), tg.pathStructIdentName(m))
 	}
 
 	return Id(tg.pathStructIdentName(m))
 }
 
+// attributeType returns the name of the expression package's constant for the attribute type that
+// the field is stored as, or an empty string if it can be stored as any type.
+func (tg *Target) attributeType(field *protogen.Field) string {
+	switch {
+	case tg.codecName(field) != "":
+		return "" // the codec decides
+	case tg.isEmbedded(field):
+		return "String" // embedded encodings are stored as a string
+	case field.Desc.IsMap():
+		return "Map"
+	case field.Desc.IsList() && tg.isSet(field):
+		switch tg.basicAttributeType(field) {
+		case "String":
+			return "StringSet"
+		case "Binary":
+			return "BinarySet"
+		default:
+			return "NumberSet"
+		}
+	case field.Desc.IsList():
+		return "List"
+	case field.Message != nil:
+		return tg.messageAttributeType(field.Message)
+	default:
+		return tg.basicAttributeType(field)
+	}
+}
+
+// elemAttributeType returns the name of the expression package's constant for the attribute type that
+// the elements of a list or map field are stored as, or an empty string if they can be any type.
+func (tg *Target) elemAttributeType(field *protogen.Field) string {
+	switch {
+	case tg.isEmbedded(field), tg.isSet(field), tg.codecName(field) != "":
+		return "" // no elements to select, or selected as the set's member type
+	case field.Desc.IsMap():
+		field = field.Message.Fields[1] // value type of the map
+	case !field.Desc.IsList():
+		return ""
+	}
+
+	if field.Message != nil {
+		return tg.messageAttributeType(field.Message)
+	}
+	return tg.basicAttributeType(field)
+}
+
+// basicAttributeType returns the attribute type of a single basic type value
+func (tg *Target) basicAttributeType(field *protogen.Field) string {
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		return "String"
+	case protoreflect.BytesKind:
+		return "Binary"
+	case protoreflect.BoolKind:
+		return "Boolean"
+	default:
+		return "Number" // enums and numbers
+	}
+}
+
+// messageAttributeType returns the attribute type of a message value
+func (tg *Target) messageAttributeType(m *protogen.Message) string {
+	if tg.isConfiguredWellKnown(m) {
+		return "" // encoded however it is registered
+	}
+
+	switch m.GoIdent.GoImportPath {
+	case "google.golang.org/protobuf/types/known/timestamppb",
+		"google.golang.org/protobuf/types/known/durationpb":
+		return "String"
+	case "google.golang.org/protobuf/types/known/structpb":
+		switch m.GoIdent.GoName {
+		case "Value":
+			return "" // dynamic values can be stored as any type
+		case "ListValue":
+			return "List"
+		}
+	case "google.golang.org/protobuf/types/known/wrapperspb":
+		switch m.GoIdent.GoName {
+		case "StringValue":
+			return "String"
+		case "BytesValue":
+			return "Binary"
+		case "BoolValue":
+			return "Boolean"
+		default:
+			return "Number"
+		}
+	}
+	return "Map"
+}
+
 // genFieldRegistration generatiosn the registration code for a field
 func (tg *Target) genFieldRegistration(field *protogen.Field) (Code, error) {
 
-	// reflect on fields message for registration
-	genFieldMsgReflect := func(m *protogen.Message) *Statement {
-		return Qual("reflect", "TypeOf").Call(Add(tg.pathStructType(m)).Values())
+	// reflect on fields message for registration, scalar well-knowns are registered as basic types
+	// since their paths cannot select into them
+	genFieldMsgReflect := func(d Dict, f *protogen.Field) {
+		if tg.notSupportPathing(f) || tg.isWellKnownScalar(f.Message) || tg.isEmbedded(field) || tg.codecName(field) != "" {
+			return
+		}
+		d[Id("Message")] = Qual("reflect", "TypeOf").Call(Add(tg.fieldPathStructType(f.Message)).Values())
+	}
+
+	d := Dict{Id("FullName"): Lit(string(field.Desc.FullName()))}
+	if at := tg.attributeType(field); at != "" {
+		d[Id("AttributeType")] = Qual(expression, at)
+	}
+	if at := tg.elemAttributeType(field); at != "" {
+		d[Id("ElemAttributeType")] = Qual(expression, at)
+	}
+	if tg.isSet(field) {
+		d[Id("Set")] = True()
+	}
+	if tg.isEmbedded(field) {
+		d[Id("Embed")] = Qual(tg.idents.ddbv1, "Encoding_"+ddbv1.Encoding_name[int32(tg.embedEncoding(field))])
+	}
+	if field.Desc.HasPresence() {
+		d[Id("Presence")] = True()
+	}
+	if codec := tg.codecName(field); codec != "" {
+		d[Id("Codec")] = Lit(codec)
 	}
 
-	d := Dict{}
 	switch {
 	case field.Desc.IsList():
 		d[Id("Kind")] = Qual(tg.idents.ddbpath, "FieldKindList")
-		if !tg.notSupportPathing(field) {
-			d[Id("Message")] = genFieldMsgReflect(field.Message)
-		}
+		genFieldMsgReflect(d, field)
 	case field.Desc.IsMap():
 		d[Id("Kind")] = Qual(tg.idents.ddbpath, "FieldKindMap")
-		val := field.Message.Fields[1] // value type of the message
-		if !tg.notSupportPathing(val) {
-			d[Id("Message")] = genFieldMsgReflect(val.Message)
-		}
+		genFieldMsgReflect(d, field.Message.Fields[1]) // value type of the message
 	case field.Message != nil:
 		d[Id("Kind")] = Qual(tg.idents.ddbpath, "FieldKindSingle")
-		if !tg.notSupportPathing(field) {
-			d[Id("Message")] = genFieldMsgReflect(field.Message)
-		}
+		genFieldMsgReflect(d, field)
 	default:
 		d[Id("Kind")] = Qual(tg.idents.ddbpath, "FieldKindSingle")
 	}
@@ -200,29 +887,68 @@ func (tg *Target) genMessagePaths(f *File, m *protogen.Message) (err error) {
 			Return(Id("p")),
 		)
 
-	// generate path building and field registration
+	// generate update methods for setting the message as a whole, the message type is checked when the
+	// update expression is build since path packages cannot refer to the message type.
+	f.Commentf("DynamoSet returns an update that sets the message at the path to 'x'")
+	f.Func().Params(Id("p").Add(tg.pathStructType(m))).Id("DynamoSet").
+		Params(Id("x").Qual("google.golang.org/protobuf/proto", "Message")).
+		Params(Qual(tg.idents.ddbpath, "Update")).
+		Block(Return(Qual(tg.idents.ddbpath, "SetMessage").Call(Id("p").Dot("NameBuilder"), Lit(string(m.Desc.FullName())), Id("x"))))
+
+	f.Commentf("DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist")
+	f.Func().Params(Id("p").Add(tg.pathStructType(m))).Id("DynamoSetIfNotExists").
+		Params(Id("x").Qual("google.golang.org/protobuf/proto", "Message")).
+		Params(Qual(tg.idents.ddbpath, "Update")).
+		Block(Return(Qual(tg.idents.ddbpath, "SetMessageIfNotExists").Call(Id("p").Dot("NameBuilder"), Lit(string(m.Desc.FullName())), Id("x"))))
+
+	f.Commentf("DynamoMessageName returns the full name of the message at the path")
+	f.Func().Params(Id("p").Add(tg.pathStructType(m))).Id("DynamoMessageName").
+		Params().
+		Params(Qual("google.golang.org/protobuf/reflect/protoreflect", "FullName")).
+		Block(Return(Lit(string(m.Desc.FullName()))))
+
+	f.Commentf("DynamoRemove returns an update that removes the message at the path")
+	f.Func().Params(Id("p").Add(tg.pathStructType(m))).Id("DynamoRemove").
+		Params().
+		Params(Qual(tg.idents.ddbpath, "Update")).
+		Block(Return(Qual(tg.idents.ddbpath, "Remove").Call(Id("p").Dot("NameBuilder"))))
+
+	// generate path building and field registration, errors of all fields are reported together
 	regFields := Dict{}
+	var errs []error
 	for _, field := range m.Fields {
 		if tg.isOmitted(field) {
 			continue // no path building for ignored fields
 		}
 
 		// add each field to the register call in the generated init
-		regFields[Lit(tg.attrName(field))], err = tg.genFieldRegistration(field)
+		reg, err := tg.genFieldRegistration(field)
 		if err != nil {
-			return fmt.Errorf("failed to generate field registration: %w", err)
+			errs = append(errs, sourceError(field.Desc, fmt.Errorf("failed to generate field registration: %w", err)))
+			continue
 		}
+		regFields[Lit(tg.attrName(field))] = reg
 
-		switch {
-		case field.Desc.IsList():
-			tg.genListFieldPath(f, m, field)
-		case field.Desc.IsMap():
-			tg.genMapFieldPath(f, m, field)
-		case field.Message != nil:
-			tg.genMessageFieldPath(f, m, field)
-		default:
-			tg.genBasicFieldPath(f, m, field)
+		// the path methods of oneof members are generated with the oneof's path group
+		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+			continue
 		}
+
+		if err = tg.genFieldPath(f, m, field); err != nil {
+			errs = append(errs, sourceError(field.Desc, err))
+		}
+	}
+
+	// generate path groups for oneofs, optional fields are synthetic oneofs that don't need them
+	for _, oneof := range m.Oneofs {
+		if oneof.Desc.IsSynthetic() {
+			continue
+		}
+		errs = append(errs, sourceError(oneof.Desc, tg.genOneofPaths(f, m, oneof)))
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		return err
 	}
 
 	// generate init functions that will register the types for path validation
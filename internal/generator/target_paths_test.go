@@ -1,14 +1,21 @@
 package generator_test
 
 import (
+	"bytes"
 	"fmt"
+	"reflect"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/crewlinker/protoc-gen-dynamodb/ddb/ddbpath"
+	commonv1 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/common/v1"
+	messagev1 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1"
 	messagev1ddbpath "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1/ddbpath"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // test the building of paths
@@ -86,8 +93,8 @@ var _ = DescribeTable("path building", func(s interface {
 		map[string]string{"#0": "33", "#1": "1"}),
 	// sets
 	Entry("string set",
-		messagev1ddbpath.Kitchen().StringSet().Index(4),
-		"#0[4]",
+		messagev1ddbpath.Kitchen().StringSet(),
+		"#0",
 		map[string]string{"#0": "28"}),
 
 	// embeddings
@@ -95,6 +102,36 @@ var _ = DescribeTable("path building", func(s interface {
 		(messagev1ddbpath.JsonFieldsPath{}).JsonEngine(),
 		"#0",
 		map[string]string{"#0": "json_engine"}),
+
+	// messages from other packages
+	Entry("other package message",
+		(messagev1ddbpath.OtherKitchenPath{}).Address().City(),
+		"#0.#1",
+		map[string]string{"#0": "18", "#1": "c"}),
+	Entry("other package nested message",
+		(messagev1ddbpath.OtherKitchenPath{}).Address().Geo().Lat(),
+		"#0.#1.#2",
+		map[string]string{"#0": "18", "#1": "4", "#2": "1"}),
+	Entry("other package message list",
+		(messagev1ddbpath.OtherKitchenPath{}).Addresses().Index(2).Tags().Index(1),
+		"#0[2].#1[1]",
+		map[string]string{"#0": "19", "#1": "3"}),
+	Entry("other package message map",
+		(messagev1ddbpath.OtherKitchenPath{}).AddressesByName().Key("home").Street(),
+		"#0.#1.#2",
+		map[string]string{"#0": "20", "#1": "home", "#2": "1"}),
+	Entry("foreign package message",
+		(messagev1ddbpath.OtherKitchenPath{}).Birthday(),
+		"#0",
+		map[string]string{"#0": "21"}),
+	Entry("foreign package message list",
+		(messagev1ddbpath.OtherKitchenPath{}).Holidays().Index(1),
+		"#0[1]",
+		map[string]string{"#0": "22"}),
+	Entry("foreign package message map",
+		(messagev1ddbpath.OtherKitchenPath{}).DatesByName().Key("xmas"),
+		"#0.#1",
+		map[string]string{"#0": "23", "#1": "xmas"}),
 )
 
 // test path validation with generated logic
@@ -119,10 +156,484 @@ var _ = DescribeTable("path validation", func(nb interface {
 	Entry("anypb", messagev1ddbpath.Kitchen(), []string{"32.foo.1"}, ``),
 	// well-known structpb.Value
 	Entry("structpb", messagev1ddbpath.Kitchen(), []string{"23.bar.dar.rab"}, ``),
+	Entry("structpb struct", messagev1ddbpath.ValueGalorePath{}, []string{"2.bar[1].rab", "4[0].foo", "4[*].foo"}, ``),
+	Entry("structpb list value", messagev1ddbpath.ValueGalorePath{}, []string{"3[2].bar", "5.foo[0]"}, ``),
 	// well-known fieldmaskpb.FieldMask
-	Entry("fieldmask", messagev1ddbpath.Kitchen(), []string{"22.1[7]"}, ``),
-	// sets
-	Entry("string set", messagev1ddbpath.Kitchen(), []string{"28[1]"}, ``),
+	Entry("fieldmask", messagev1ddbpath.Kitchen(), []string{"22.1"}, ``),
+	Entry("fieldmask index", messagev1ddbpath.Kitchen(), []string{"22.1[7]"}, `indexing '7' not allowed on List\(SS\)`),
+	// sets cannot be indexed
+	Entry("string set", messagev1ddbpath.Kitchen(), []string{"28"}, ``),
+	Entry("string set index", messagev1ddbpath.Kitchen(), []string{"28[1]"}, `indexing '1' not allowed on List\(SS\)`),
+	Entry("number set wildcard", messagev1ddbpath.Kitchen(), []string{"29[*]"}, `field selecting '\*' not allowed on List\(NS\)`),
 	// travers embedding should fail
-	Entry("embedding", (messagev1ddbpath.JsonFieldsPath{}), []string{"json_engine.1"}, `field selecting '1' not allowed on Single`),
+	Entry("embedding", (messagev1ddbpath.JsonFieldsPath{}), []string{"json_engine.1"}, `field selecting '1' not allowed on Single\(embedded ENCODING_JSON\)`),
+	Entry("embedded list", (messagev1ddbpath.JsonFieldsPath{}), []string{"2[0]"}, `indexing '0' not allowed on List\(embedded ENCODING_JSON\)`),
+	Entry("embedded map", (messagev1ddbpath.JsonFieldsPath{}), []string{"5.foo.1"}, `field selecting 'foo' not allowed on Map\(embedded ENCODING_JSON\)`),
+	// messages from other packages
+	Entry("other package", (messagev1ddbpath.OtherKitchenPath{}), []string{"18.c", "18.4.2", "19[3].3[1]", "20.home.1"}, ``),
+	// messages from packages without generated paths cannot be selected into
+	Entry("foreign package", (messagev1ddbpath.OtherKitchenPath{}), []string{"21", "22[1]", "23.xmas"}, ``),
+	Entry("foreign package field", (messagev1ddbpath.OtherKitchenPath{}), []string{"21.1"}, `field selecting '1' not allowed on Single`),
+	// scalar well-knowns cannot be selected into
+	Entry("timestamp list", messagev1ddbpath.Kitchen(), []string{"27[1]", "18"}, ``),
+	Entry("timestamp field", messagev1ddbpath.Kitchen(), []string{"18.1"}, `field selecting '1' not allowed on Single`),
+	Entry("other package unknown field", (messagev1ddbpath.OtherKitchenPath{}), []string{"18.2"}, `unknown field '2' of Single<commonv1ddbpath.AddressPath>`),
+)
+
+// test operand validation with generated logic
+var _ = DescribeTable("operand validation", func(nb ddbpath.NameBuilder, p string, av types.AttributeValue, expErr string) {
+	err := ddbpath.ValidateOperand(nb, p, av)
+	if expErr == "" {
+		Expect(err).To(BeNil())
+	} else {
+		Expect(err).To(MatchError(MatchRegexp(expErr)))
+	}
+},
+	Entry("string", messagev1ddbpath.Kitchen(), "1", &types.AttributeValueMemberS{Value: "foo"}, ``),
+	Entry("string mismatch", messagev1ddbpath.Kitchen(), "1", &types.AttributeValueMemberN{Value: "1"},
+		`invalid operand for path '1': operand of type 'N' not allowed on Single of type 'S'`),
+	Entry("enum", messagev1ddbpath.FieldPresencePath{}, "enum", &types.AttributeValueMemberN{Value: "1"}, ``),
+	Entry("set", messagev1ddbpath.Kitchen(), "28", &types.AttributeValueMemberSS{Value: []string{"a"}}, ``),
+	Entry("set element", messagev1ddbpath.Kitchen(), "28", &types.AttributeValueMemberS{Value: "a"}, ``),
+	Entry("set element mismatch", messagev1ddbpath.Kitchen(), "29", &types.AttributeValueMemberS{Value: "a"},
+		`operand of type 'S' not allowed on List\(NS\) of type 'NS'`),
+	Entry("message", messagev1ddbpath.Kitchen(), "16", &types.AttributeValueMemberM{}, ``),
+	Entry("message field", messagev1ddbpath.Kitchen(), "16.2", &types.AttributeValueMemberS{Value: "a"}, `operand of type 'S'`),
+	Entry("list element", messagev1ddbpath.Kitchen(), "19[0]", &types.AttributeValueMemberM{}, ``),
+	Entry("basic list element", messagev1ddbpath.Kitchen(), "20[0]", &types.AttributeValueMemberS{Value: "a"}, ``),
+	Entry("timestamp", messagev1ddbpath.Kitchen(), "18", &types.AttributeValueMemberS{Value: "2023-01-01T00:00:00Z"}, ``),
+	Entry("dynamic value", messagev1ddbpath.Kitchen(), "23.foo", &types.AttributeValueMemberBOOL{Value: true}, ``),
+	Entry("embedded", messagev1ddbpath.JsonFieldsPath{}, "json_engine", &types.AttributeValueMemberM{}, `operand of type 'M'`),
+	Entry("basic list element mismatch", messagev1ddbpath.Kitchen(), "20[0]", &types.AttributeValueMemberN{Value: "1"},
+		`operand of type 'N' not allowed on Single of type 'S'`),
+	Entry("enum map value", messagev1ddbpath.MapGalorePath{}, "21.foo", &types.AttributeValueMemberN{Value: "1"}, ``),
+	Entry("enum map value mismatch", messagev1ddbpath.MapGalorePath{}, "21.foo", &types.AttributeValueMemberS{Value: "a"},
+		`operand of type 'S' not allowed on Single of type 'N'`),
+	Entry("bytes map value", messagev1ddbpath.MapGalorePath{}, "22.1", &types.AttributeValueMemberB{Value: []byte{1}}, ``),
+	Entry("timestamp map value", messagev1ddbpath.MapGalorePath{}, "23.true", &types.AttributeValueMemberS{Value: "2023-01-01T00:00:00Z"}, ``),
+	Entry("wrapper map value", messagev1ddbpath.MapGalorePath{}, "25.foo", &types.AttributeValueMemberN{Value: "1"}, ``),
+	Entry("wrapper map value mismatch", messagev1ddbpath.MapGalorePath{}, "26.foo", &types.AttributeValueMemberS{Value: "a"},
+		`operand of type 'S' not allowed on Single of type 'BOOL'`),
+	Entry("message map value", messagev1ddbpath.MapGalorePath{}, "18.true", &types.AttributeValueMemberM{}, ``),
+	Entry("struct", messagev1ddbpath.ValueGalorePath{}, "2", &types.AttributeValueMemberM{}, ``),
+	Entry("struct list element", messagev1ddbpath.ValueGalorePath{}, "4[0]", &types.AttributeValueMemberM{}, ``),
+	Entry("list value", messagev1ddbpath.ValueGalorePath{}, "3", &types.AttributeValueMemberL{}, ``),
+	Entry("list value mismatch", messagev1ddbpath.ValueGalorePath{}, "3", &types.AttributeValueMemberM{}, `operand of type 'M'`),
+	Entry("list value map value", messagev1ddbpath.ValueGalorePath{}, "5.foo", &types.AttributeValueMemberL{}, ``),
+	Entry("struct field", messagev1ddbpath.ValueGalorePath{}, "2.foo", &types.AttributeValueMemberBOOL{Value: true}, ``),
+	Entry("list value element", messagev1ddbpath.ValueGalorePath{}, "5.foo[1]", &types.AttributeValueMemberS{Value: "a"}, ``),
+	Entry("invalid path", messagev1ddbpath.Kitchen(), "28[0]", &types.AttributeValueMemberS{Value: "a"}, `failed to traverse path '28\[0\]'`),
+)
+
+// test typed conditions on scalar well-known paths
+var _ = DescribeTable("well-known conditions", func(c expression.ConditionBuilder, expCondition string, expValues map[string]types.AttributeValue) {
+	expr, err := expression.NewBuilder().WithCondition(c).Build()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(*expr.Condition()).To(Equal(expCondition))
+	Expect(expr.Values()).To(Equal(expValues))
+},
+	Entry("timestamp equal",
+		messagev1ddbpath.Kitchen().WallTime().Equal(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)),
+		"#0 = :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "2023-01-02T03:04:05Z"}}),
+	Entry("timestamp not equal",
+		messagev1ddbpath.Kitchen().WallTime().NotEqual(time.Unix(1, 5e8)),
+		"#0 <> :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "1970-01-01T00:00:01.500Z"}}),
+	Entry("timestamp in list",
+		messagev1ddbpath.Kitchen().ListOfTs().Index(1).Equal(time.Unix(0, 0)),
+		"#0[1] = :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "1970-01-01T00:00:00Z"}}),
+	Entry("timestamp before",
+		messagev1ddbpath.Kitchen().WallTime().Before(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)),
+		"#0 < :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "2023-01-02T03:04:05.000000000Z"}}),
+	Entry("timestamp after, in UTC",
+		messagev1ddbpath.Kitchen().ListOfTs().Index(1).After(time.Date(2023, 1, 2, 5, 4, 5, 5e8, time.FixedZone("", 3600*2))),
+		"#0[1] > :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "2023-01-02T03:04:05.500000000Z"}}),
+	Entry("timestamp between",
+		messagev1ddbpath.Kitchen().WallTime().Between(time.Unix(0, 0), time.Unix(1, 5e8)),
+		"#0 BETWEEN :0 AND :1",
+		map[string]types.AttributeValue{
+			":0": &types.AttributeValueMemberS{Value: "1970-01-01T00:00:00.000000000Z"},
+			":1": &types.AttributeValueMemberS{Value: "1970-01-01T00:00:01.500000000Z"},
+		}),
+	Entry("duration equal",
+		messagev1ddbpath.Kitchen().Timer().Equal(time.Second*3/2),
+		"#0 = :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "1.500s"}}),
+	Entry("string value equal",
+		messagev1ddbpath.Kitchen().ValStr().Equal("foo"),
+		"#0 = :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "foo"}}),
+	Entry("bytes value equal",
+		messagev1ddbpath.Kitchen().ValBytes().Equal([]byte{0x01}),
+		"#0 = :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberB{Value: []byte{0x01}}}),
+	Entry("bool value equal",
+		(messagev1ddbpath.FieldPresencePath{}).BoolVal().Equal(true),
+		"#0 = :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberBOOL{Value: true}}),
+	Entry("number value between",
+		(messagev1ddbpath.FieldPresencePath{}).Int64Val().Between(-1, 10),
+		"#0 BETWEEN :0 AND :1",
+		map[string]types.AttributeValue{
+			":0": &types.AttributeValueMemberN{Value: "-1"},
+			":1": &types.AttributeValueMemberN{Value: "10"},
+		}),
+	Entry("float value less than",
+		(messagev1ddbpath.FieldPresencePath{}).FloatVal().LessThan(1.5),
+		"#0 < :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberN{Value: "1.5"}}),
+)
+
+// test typed conditions on basic type paths
+var _ = DescribeTable("typed conditions", func(c expression.ConditionBuilder, expCondition string, expValues map[string]types.AttributeValue) {
+	expr, err := expression.NewBuilder().WithCondition(c).Build()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(*expr.Condition()).To(Equal(expCondition))
+	Expect(expr.Values()).To(Equal(expValues))
+},
+	Entry("string begins with",
+		messagev1ddbpath.Kitchen().Brand().BeginsWith("foo"),
+		"begins_with (#0, :0)",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "foo"}}),
+	Entry("string in",
+		messagev1ddbpath.Kitchen().Brand().In("a", "b"),
+		"#0 IN (:0, :1)",
+		map[string]types.AttributeValue{
+			":0": &types.AttributeValueMemberS{Value: "a"},
+			":1": &types.AttributeValueMemberS{Value: "b"},
+		}),
+	Entry("number between",
+		messagev1ddbpath.Kitchen().NumSmallForks().Between(-1, 10),
+		"#0 BETWEEN :0 AND :1",
+		map[string]types.AttributeValue{
+			":0": &types.AttributeValueMemberN{Value: "-1"},
+			":1": &types.AttributeValueMemberN{Value: "10"},
+		}),
+	Entry("float greater than",
+		messagev1ddbpath.Kitchen().PercentBlackTiles().GreaterThan(0.5),
+		"#0 > :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberN{Value: "0.5"}}),
+	Entry("bytes equal",
+		messagev1ddbpath.Kitchen().QrCode().Equal([]byte{0x01}),
+		"#0 = :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberB{Value: []byte{0x01}}}),
+	Entry("bytes begins with",
+		messagev1ddbpath.Kitchen().QrCode().BeginsWith([]byte{0x01, 0x02}),
+		"#0 BETWEEN :0 AND :1",
+		map[string]types.AttributeValue{
+			":0": &types.AttributeValueMemberB{Value: []byte{0x01, 0x02}},
+			":1": &types.AttributeValueMemberB{Value: append([]byte{0x01, 0x02}, bytes.Repeat([]byte{0xFF}, 1022)...)},
+		}),
+	Entry("bytes begins with nothing",
+		messagev1ddbpath.Kitchen().QrCode().BeginsWith(nil),
+		"attribute_type (#0, :0)",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "B"}}),
+	Entry("bool not equal",
+		messagev1ddbpath.Kitchen().IsRenovated().NotEqual(true),
+		"#0 <> :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberBOOL{Value: true}}),
+	Entry("enum equal",
+		messagev1ddbpath.Kitchen().Dirtyness().Equal(messagev1.Dirtyness_DIRTYNESS_CLEAN),
+		"#0 = :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberN{Value: "1"}}),
+	Entry("enum of other package equal",
+		(messagev1ddbpath.OtherKitchenPath{}).Country().Equal(commonv1.Country_COUNTRY_NL),
+		"#0 = :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberN{Value: "1"}}),
+	Entry("string list index",
+		messagev1ddbpath.Kitchen().OtherBrands().Index(2).LessThanEqual("x"),
+		"#0[2] <= :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "x"}}),
+	Entry("string list contains",
+		messagev1ddbpath.Kitchen().OtherBrands().Contains("foo"),
+		"contains (#0, :0)",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "foo"}}),
+	Entry("string set contains",
+		messagev1ddbpath.Kitchen().StringSet().Contains("foo"),
+		"contains (#0, :0)",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "foo"}}),
+	Entry("set exists",
+		messagev1ddbpath.Kitchen().NumberSet().AttributeExists(),
+		"attribute_exists (#0)",
+		nil),
+	Entry("number map key",
+		messagev1ddbpath.Kitchen().Calendar().Key("bar").Equal(3),
+		"#0.#1 = :0",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberN{Value: "3"}}),
+	Entry("attribute type",
+		messagev1ddbpath.Kitchen().Brand().AttributeType(expression.String),
+		"attribute_type (#0, :0)",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "S"}}),
+	Entry("compare attributes",
+		messagev1ddbpath.Kitchen().Brand().Size().GreaterThan(messagev1ddbpath.Kitchen().NumSmallKnifes()),
+		"size (#0) > #1",
+		nil),
+)
+
+var _ = Describe("well-known operands", func() {
+	It("should error on build when the operand cannot be marshalled", func() {
+		_, err := expression.NewBuilder().WithCondition(
+			messagev1ddbpath.Kitchen().WallTime().Equal(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC))).Build()
+		Expect(err).To(MatchError(MatchRegexp(`failed to marshal operand`)))
+	})
+
+	It("should error on build when an ordering operand is out of range", func() {
+		_, err := expression.NewBuilder().WithCondition(
+			messagev1ddbpath.Kitchen().WallTime().Before(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC))).Build()
+		Expect(err).To(MatchError(MatchRegexp(`failed to marshal operand`)))
+	})
+
+	It("should order sortable timestamps as strings", func() {
+		ts := []time.Time{
+			time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+			time.Date(2023, 1, 2, 3, 4, 5, 5e8, time.UTC),
+			time.Date(2023, 1, 2, 3, 4, 5, 500001000, time.UTC),
+			time.Date(2023, 1, 2, 4, 4, 5, 1, time.FixedZone("", 3600*2)), // earliest, in another zone
+		}
+		for i := range ts {
+			for j := range ts {
+				a := ts[i].UTC().Format(ddbpath.SortableTimestampLayout)
+				b := ts[j].UTC().Format(ddbpath.SortableTimestampLayout)
+				Expect(a < b).To(Equal(ts[i].Before(ts[j])), "%s < %s", a, b)
+			}
+		}
+	})
+
+	It("should not index sets", func() {
+		for _, typ := range []reflect.Type{
+			reflect.TypeOf(ddbpath.StringSet{}),
+			reflect.TypeOf(ddbpath.ValueSet[int64]{}),
+			reflect.TypeOf(ddbpath.EnumSet[commonv1.Country]{}),
+		} {
+			_, ok := typ.MethodByName("Index")
+			Expect(ok).To(BeFalse(), typ.String())
+		}
+	})
+
+	It("should error on build when setting an empty set", func() {
+		_, err := expression.NewBuilder().WithUpdate(ddbpath.Updates(
+			messagev1ddbpath.Kitchen().StringSet().Set([]string{}))).Build()
+		Expect(err).To(MatchError(MatchRegexp(`DynamoDB doesn't allow empty sets`)))
+	})
+})
+
+// test typed updates on generated paths
+var _ = DescribeTable("typed updates", func(u []ddbpath.Update, expUpdate string, expValues map[string]types.AttributeValue) {
+	expr, err := expression.NewBuilder().WithUpdate(ddbpath.Updates(u...)).Build()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(*expr.Update()).To(Equal(expUpdate))
+	Expect(expr.Values()).To(Equal(expValues))
+},
+	Entry("nested set and number add",
+		[]ddbpath.Update{
+			messagev1ddbpath.Kitchen().ExtraKitchen().Brand().Set("x"),
+			messagev1ddbpath.Kitchen().NumSmallForks().Add(2),
+		},
+		"ADD #0 :0\nSET #1.#2 = :1\n",
+		map[string]types.AttributeValue{
+			":0": &types.AttributeValueMemberN{Value: "2"},
+			":1": &types.AttributeValueMemberS{Value: "x"},
+		}),
+	Entry("set if not exists",
+		[]ddbpath.Update{messagev1ddbpath.Kitchen().Dirtyness().SetIfNotExists(messagev1.Dirtyness_DIRTYNESS_CLEAN)},
+		"SET #0 = if_not_exists(#0, :0)\n",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberN{Value: "1"}}),
+	Entry("set add and delete",
+		[]ddbpath.Update{
+			messagev1ddbpath.Kitchen().StringSet().Add("a", "b"),
+			messagev1ddbpath.Kitchen().NumberSet().Delete(1),
+		},
+		"ADD #0 :0\nDELETE #1 :1\n",
+		map[string]types.AttributeValue{
+			":0": &types.AttributeValueMemberSS{Value: []string{"a", "b"}},
+			":1": &types.AttributeValueMemberNS{Value: []string{"1"}},
+		}),
+	Entry("enum and float set add and delete",
+		[]ddbpath.Update{
+			messagev1ddbpath.SetGalorePath{}.EnumSet().Add(messagev1.Dirtyness_DIRTYNESS_CLEAN),
+			messagev1ddbpath.SetGalorePath{}.FloatSet().Delete(1.5),
+		},
+		"ADD #0 :0\nDELETE #1 :1\n",
+		map[string]types.AttributeValue{
+			":0": &types.AttributeValueMemberNS{Value: []string{"1"}},
+			":1": &types.AttributeValueMemberNS{Value: []string{"1.5"}},
+		}),
+	Entry("enum of other package set",
+		[]ddbpath.Update{(messagev1ddbpath.OtherKitchenPath{}).Visited().Add(commonv1.Country_COUNTRY_DE, commonv1.Country_COUNTRY_NL)},
+		"ADD #0 :0\n",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberNS{Value: []string{"1", "2"}}}),
+	Entry("list append and remove",
+		[]ddbpath.Update{
+			messagev1ddbpath.Kitchen().OtherBrands().ListAppend("c"),
+			messagev1ddbpath.Kitchen().IsRenovated().Remove(),
+		},
+		"REMOVE #0\nSET #1 = list_append(#1, :0)\n",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+			&types.AttributeValueMemberS{Value: "c"},
+		}}}),
+	Entry("message list append",
+		[]ddbpath.Update{messagev1ddbpath.Kitchen().ApplianceEngines().ListAppend(&messagev1.Engine{Brand: "q"})},
+		"SET #0 = list_append(#0, :0)\n",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+			&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{"1": &types.AttributeValueMemberS{Value: "q"}}},
+		}}}),
+	Entry("set message",
+		[]ddbpath.Update{messagev1ddbpath.Kitchen().WasherEngine().DynamoSet(&messagev1.Engine{Brand: "z"})},
+		"SET #0 = :0\n",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+			"1": &types.AttributeValueMemberS{Value: "z"},
+		}}}),
+	Entry("set well-known",
+		[]ddbpath.Update{messagev1ddbpath.Kitchen().Timer().Set(time.Second)},
+		"SET #0 = :0\n",
+		map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "1s"}}),
+	Entry("set embedded",
+		[]ddbpath.Update{
+			(messagev1ddbpath.JsonFieldsPath{}).JsonEngine().Set(&messagev1.Engine{Brand: "z"}),
+			(messagev1ddbpath.JsonFieldsPath{}).JsonStrList().Set([]string{"a"}),
+		},
+		"SET #0 = :0, #1 = :1\n",
+		map[string]types.AttributeValue{
+			":0": &types.AttributeValueMemberS{Value: `{"brand":"z"}`},
+			":1": &types.AttributeValueMemberS{Value: `["a"]`},
+		}),
+)
+
+var _ = Describe("typed update errors", func() {
+	It("should error on build when setting a message of the wrong type", func() {
+		_, err := expression.NewBuilder().WithUpdate(ddbpath.Updates(
+			messagev1ddbpath.Kitchen().WasherEngine().DynamoSet(&messagev1.Car{}))).Build()
+		Expect(err).To(MatchError(MatchRegexp(`must be of type 'example.message.v1.Engine', got: 'example.message.v1.Car'`)))
+	})
+
+	It("should error on build when appending messages of the wrong type", func() {
+		_, err := expression.NewBuilder().WithUpdate(ddbpath.Updates(
+			messagev1ddbpath.Kitchen().ApplianceEngines().ListAppend(&messagev1.Engine{}, &messagev1.Car{}))).Build()
+		Expect(err).To(MatchError(MatchRegexp(`message '1' must be of type 'example.message.v1.Engine', got: 'example.message.v1.Car'`)))
+
+		_, err = expression.NewBuilder().WithUpdate(ddbpath.Updates(
+			messagev1ddbpath.Kitchen().ListOfTs().ListAppend(durationpb.New(time.Second)))).Build()
+		Expect(err).To(MatchError(MatchRegexp(`must be of type 'google.protobuf.Timestamp', got: 'google.protobuf.Duration'`)))
+
+		_, err = expression.NewBuilder().WithUpdate(ddbpath.Updates(
+			(messagev1ddbpath.OtherKitchenPath{}).Addresses().ListAppend(&messagev1.Engine{}))).Build()
+		Expect(err).To(MatchError(MatchRegexp(`must be of type 'example.common.v1.Address', got: 'example.message.v1.Engine'`)))
+	})
+
+	It("should error on build when embedding a list of messages", func() {
+		_, err := expression.NewBuilder().WithUpdate(ddbpath.Updates(
+			(messagev1ddbpath.JsonFieldsPath{}).JsonEngineList().Set([]*messagev1.Engine{{}}))).Build()
+		Expect(err).To(MatchError(MatchRegexp(`unsupported embedded value`)))
+	})
+})
+
+// test oneof path groups
+var _ = Describe("oneof paths", func() {
+	It("should remove the other members when setting a member", func() {
+		expr, err := expression.NewBuilder().WithUpdate(ddbpath.Updates(
+			(messagev1ddbpath.FieldPresencePath{}).Oo().SetOneofStr("foo"))).Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*expr.Update()).To(Equal("REMOVE #0\nSET #1 = :0\n"))
+		Expect(expr.Names()).To(Equal(map[string]string{"#0": "oneofMsg", "#1": "oneofStr"}))
+		Expect(expr.Values()).To(Equal(map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: "foo"}}))
+	})
+
+	It("should set embedded message members", func() {
+		expr, err := expression.NewBuilder().WithUpdate(ddbpath.Updates(
+			(messagev1ddbpath.JsonOneofsPath{}).JsonOo().SetOneofMsg(&messagev1.Engine{Brand: "foo"}))).Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*expr.Update()).To(Equal("REMOVE #0\nSET #1 = :0\n"))
+		Expect(expr.Names()).To(Equal(map[string]string{"#0": "7", "#1": "8"}))
+		Expect(expr.Values()).To(Equal(map[string]types.AttributeValue{":0": &types.AttributeValueMemberS{Value: `{"brand":"foo"}`}}))
+	})
+
+	It("should remove the other members when setting through the member's path", func() {
+		for _, upd := range []ddbpath.Update{
+			(messagev1ddbpath.FieldPresencePath{}).OneofStr().Set("foo"),
+			(messagev1ddbpath.FieldPresencePath{}).OneofStr().SetIfNotExists("foo"),
+			(messagev1ddbpath.FieldPresencePath{}).OneofMsg().DynamoSet(&messagev1.Engine{Brand: "foo"}),
+			(messagev1ddbpath.JsonOneofsPath{}).OneofMsg().Set(&messagev1.Engine{Brand: "foo"}),
+			(messagev1ddbpath.PricedPath{}).FixedPrice().Set(1.25),
+		} {
+			expr, err := expression.NewBuilder().WithUpdate(ddbpath.Updates(upd)).Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*expr.Update()).To(HavePrefix("REMOVE #0\n"))
+		}
+	})
+
+	It("should allow conditions on members", func() {
+		expr, err := expression.NewBuilder().WithCondition(expression.And(
+			(messagev1ddbpath.FieldPresencePath{}).OneofStr().BeginsWith("fo"),
+			(messagev1ddbpath.FieldPresencePath{}).OneofMsg().AttributeExists(),
+		)).Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*expr.Condition()).To(Equal("(begins_with (#0, :0)) AND (attribute_exists (#1))"))
+		Expect(expr.Names()).To(Equal(map[string]string{"#0": "oneofStr", "#1": "oneofMsg"}))
+	})
+
+	It("should not select nested fields of message members", func() {
+		typ := reflect.TypeOf((messagev1ddbpath.FieldPresencePath{}).OneofMsg())
+		_, ok := typ.MethodByName("Brand")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should set message members as a whole, removing the other members", func() {
+		expr, err := expression.NewBuilder().WithUpdate(ddbpath.Updates(
+			(messagev1ddbpath.FieldPresencePath{}).OneofMsg().DynamoSet(&messagev1.Engine{Brand: "foo"}))).Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*expr.Update()).To(Equal("REMOVE #0\nSET #1 = :0\n"))
+		Expect(expr.Names()).To(Equal(map[string]string{"#0": "oneofStr", "#1": "oneofMsg"}))
+		Expect(expr.Values()).To(Equal(map[string]types.AttributeValue{":0": &types.AttributeValueMemberM{
+			Value: map[string]types.AttributeValue{"1": &types.AttributeValueMemberS{Value: "foo"}},
+		}}))
+	})
+
+	It("should error on build when setting embedded message members of the wrong type", func() {
+		for _, upd := range []ddbpath.Update{
+			(messagev1ddbpath.JsonOneofsPath{}).JsonOo().SetOneofMsg(durationpb.New(time.Second)),
+			(messagev1ddbpath.JsonOneofsPath{}).OneofMsg().Set(durationpb.New(time.Second)),
+			(messagev1ddbpath.JsonFieldsPath{}).JsonEngine().Set(durationpb.New(time.Second)),
+		} {
+			_, err := expression.NewBuilder().WithUpdate(ddbpath.Updates(upd)).Build()
+			Expect(err).To(MatchError(MatchRegexp(`must be of type 'example.message.v1.Engine', got: 'google.protobuf.Duration'`)))
+		}
+
+		_, err := expression.NewBuilder().WithUpdate(ddbpath.Updates(
+			(messagev1ddbpath.JsonFieldsPath{}).JsonEngine().Set("foo"))).Build()
+		Expect(err).To(MatchError(MatchRegexp(`must be a message of type 'example.message.v1.Engine', got: string`)))
+	})
+
+	It("should check which member is set", func() {
+		expr, err := expression.NewBuilder().WithCondition(
+			(messagev1ddbpath.FieldPresencePath{}).Oo().WhichOneofMsg()).Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*expr.Condition()).To(Equal("(attribute_exists (#0)) AND (attribute_not_exists (#1))"))
+		Expect(expr.Names()).To(Equal(map[string]string{"#0": "oneofMsg", "#1": "oneofStr"}))
+	})
+})
+
+var _ = DescribeTable("registered attribute types", func(nb ddbpath.NameBuilder, name string, exp string) {
+	fields, ok := ddbpath.DefaultRegistry().FieldsOf(nb)
+	Expect(ok).To(BeTrue())
+	Expect(fields).To(HaveKey(name))
+	Expect(string(fields[name].AttributeType)).To(Equal(exp))
+},
+	Entry("string", messagev1ddbpath.Kitchen(), "1", "S"),
+	Entry("bool", messagev1ddbpath.Kitchen(), "2", "BOOL"),
+	Entry("bytes", messagev1ddbpath.FieldPresencePath{}, "bytesVal", "B"),
+	Entry("enum", messagev1ddbpath.FieldPresencePath{}, "enum", "N"),
+	Entry("message", messagev1ddbpath.Kitchen(), "16", "M"),
+	Entry("list", messagev1ddbpath.Kitchen(), "20", "L"),
+	Entry("map", messagev1ddbpath.Kitchen(), "14", "M"),
+	Entry("string set", messagev1ddbpath.Kitchen(), "28", "SS"),
+	Entry("number set", messagev1ddbpath.Kitchen(), "29", "NS"),
+	Entry("binary set", messagev1ddbpath.Kitchen(), "30", "BS"),
+	Entry("timestamp", messagev1ddbpath.Kitchen(), "18", "S"),
+	Entry("any", messagev1ddbpath.Kitchen(), "21", "M"),
+	Entry("dynamic value", messagev1ddbpath.Kitchen(), "23", ""),
+	Entry("embedded", messagev1ddbpath.JsonFieldsPath{}, "json_engine", "S"),
 )
@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"errors"
 	"fmt"
 
 	. "github.com/dave/jennifer/jen"
@@ -9,19 +10,28 @@ import (
 )
 
 // generate marshalling code for a map field.
-func (tg *Target) genMapFieldUnmarshal(f *protogen.Field) (c []Code) {
+func (tg *Target) genMapFieldUnmarshal(f *protogen.Field) (c []Code, err error) {
 	key := f.Message.Fields[0]
 	val := f.Message.Fields[1]
 
 	// if the map value is not a message. We don't need to faciliate recursing so
 	// we can just unmarshal it as a basic value.
 	if val.Message == nil {
-		return tg.genBasicFieldUnmarshal(f)
+		return tg.genBasicFieldUnmarshal(f), nil
 	}
 
 	// we cannot solve key unmarshalling using type parameters so we determine
 	// the correct function here.
-	keyType := tg.fieldGoType(key)
+	keyType, err := tg.fieldGoType(key)
+	if err != nil {
+		return nil, err
+	}
+
+	valType, err := tg.fieldGoType(val)
+	if err != nil {
+		return nil, err
+	}
+
 	var keyFunc *Statement
 	switch key.Desc.Kind() {
 	case protoreflect.StringKind:
@@ -35,14 +45,14 @@ func (tg *Target) genMapFieldUnmarshal(f *protogen.Field) (c []Code) {
 		protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
 		keyFunc = Qual(tg.idents.ddb, "IntMapKey").Types(keyType)
 	default:
-		panic("unsupported map key type: " + key.Desc.Kind().String())
+		return nil, fmt.Errorf("field '%s' has a map key of unsupported kind '%s'", f.GoName, key.Desc.Kind())
 	}
 
 	// defer to the generic unmarshal implementation
 	return []Code{
 		If(Id("m").Index(Lit(tg.attrName(f))).Op("!=").Nil()).Block(
 			List(Id("x").Dot(f.GoName),
-				Err()).Op("=").Qual(tg.idents.ddb, "UnmarshalMappedMessage").Types(keyType, tg.fieldGoType(val)).Call(
+				Err()).Op("=").Qual(tg.idents.ddb, "UnmarshalMappedMessage").Types(keyType, valType).Call(
 				Id("m").Index(Lit(tg.attrName(f))),
 				keyFunc,
 				tg.genEmbedOption(f),
@@ -51,15 +61,20 @@ func (tg *Target) genMapFieldUnmarshal(f *protogen.Field) (c []Code) {
 				Return(Qual("fmt", "Errorf").Call(Lit("failed to unmarshal repeated message field '"+f.GoName+"': %w"), Err())),
 			),
 		),
-	}
+	}, nil
 }
 
 // generate nested message marshalling
-func (tg *Target) genMessageFieldUnmarshal(f *protogen.Field) []Code {
+func (tg *Target) genMessageFieldUnmarshal(f *protogen.Field) ([]Code, error) {
+	typ, err := tg.fieldGoType(f)
+	if err != nil {
+		return nil, err
+	}
+
 	return []Code{
 		// only unmarshal map, if the attribute is not nil
 		If(Id("m").Index(Lit(tg.attrName(f))).Op("!=").Nil()).Block(
-			Id("x").Dot(f.GoName).Op("=").New(tg.fieldGoType(f)),
+			Id("x").Dot(f.GoName).Op("=").New(typ),
 			Err().Op("=").Qual(tg.idents.ddb, "UnmarshalMessage").Call(
 				Id("m").Index(Lit(tg.attrName(f))),
 				Id("x").Dot(f.GoName),
@@ -69,7 +84,7 @@ func (tg *Target) genMessageFieldUnmarshal(f *protogen.Field) []Code {
 				Return(Qual("fmt", "Errorf").Call(Lit("failed to unmarshal field '"+f.GoName+"': %w"), Err())),
 			),
 		),
-	}
+	}, nil
 }
 
 // basic field generates code for marshaling a regular field with basic types
@@ -88,18 +103,23 @@ func (tg *Target) genBasicFieldUnmarshal(f *protogen.Field) []Code {
 }
 
 // genListFieldUnmarshal generates Unmarshal code for a repeated field
-func (tg *Target) genListFieldUnmarshal(f *protogen.Field) []Code {
+func (tg *Target) genListFieldUnmarshal(f *protogen.Field) ([]Code, error) {
 	// if its not a list of messages, no recursing is necessary and we can just
 	// unmarshal like a basic type
 	if f.Message == nil {
-		return tg.genBasicFieldUnmarshal(f)
+		return tg.genBasicFieldUnmarshal(f), nil
+	}
+
+	typ, err := tg.fieldGoType(f)
+	if err != nil {
+		return nil, err
 	}
 
 	// for messages we loop over each item and unmarshal them one by one
 	return []Code{
 		If(Id("m").Index(Lit(tg.attrName(f))).Op("!=").Nil()).Block(
 			List(Id("x").Dot(f.GoName),
-				Err()).Op("=").Qual(tg.idents.ddb, "UnmarshalRepeatedMessage").Types(tg.fieldGoType(f)).Call(
+				Err()).Op("=").Qual(tg.idents.ddb, "UnmarshalRepeatedMessage").Types(typ).Call(
 				Id("m").Index(Lit(tg.attrName(f))),
 				tg.genEmbedOption(f),
 			),
@@ -107,12 +127,12 @@ func (tg *Target) genListFieldUnmarshal(f *protogen.Field) []Code {
 				Return(Qual("fmt", "Errorf").Call(Lit("failed to unmarshal repeated message field '"+f.GoName+"': %w"), Err())),
 			),
 		),
-	}
+	}, nil
 }
 
 // genOneOfFieldUnmarshal generates unmarshal code for one-of fields. This needs special care because
 // the optional value is held in a special "FieldPresence_" type.
-func (tg *Target) genOneOfFieldUnmarshal(f *protogen.Field) []Code {
+func (tg *Target) genOneOfFieldUnmarshal(f *protogen.Field) ([]Code, error) {
 	unmarshal := []Code{
 		Var().Id("mo").Id(fmt.Sprintf("%s_%s", f.Parent.GoIdent.GoName, f.GoName)),
 	}
@@ -120,8 +140,13 @@ func (tg *Target) genOneOfFieldUnmarshal(f *protogen.Field) []Code {
 	switch {
 	case f.Message != nil:
 		// oneof field is a message
+		typ, err := tg.fieldGoType(f)
+		if err != nil {
+			return nil, err
+		}
+
 		unmarshal = append(unmarshal,
-			Id("mo").Dot(f.GoName).Op("=").New(tg.fieldGoType(f)),
+			Id("mo").Dot(f.GoName).Op("=").New(typ),
 			Err().Op("=").Qual(tg.idents.ddb, "UnmarshalMessage").Call(
 				Id("m").Index(Lit(tg.attrName(f))), Id("mo").Dot(f.GoName),
 				tg.genEmbedOption(f),
@@ -148,12 +173,75 @@ func (tg *Target) genOneOfFieldUnmarshal(f *protogen.Field) []Code {
 
 	return []Code{
 		If(Id("m").Index(Lit(tg.attrName(f))).Op("!=").Nil()).Block(unmarshal...),
+	}, nil
+}
+
+// genCodecFieldUnmarshal generates code that unmarshals a field with the codec that is registered for it.
+// The codec is passed a pointer to the value, messages and optional fields are allocated before.
+func (tg *Target) genCodecFieldUnmarshal(f *protogen.Field) ([]Code, error) {
+	var pre, post []Code
+	dst := Op("&").Id("x").Dot(f.GoName)
+	switch {
+	case f.Desc.IsList() || f.Desc.IsMap():
+	case f.Oneof != nil && !f.Desc.HasOptionalKeyword():
+		// oneof members are held in a wrapper type, that is set after decoding
+		pre = append(pre, Var().Id("mo").Id(fmt.Sprintf("%s_%s", f.Parent.GoIdent.GoName, f.GoName)))
+		post = append(post, Id("x").Dot(f.Oneof.GoName).Op("=").Op("&").Id("mo"))
+		dst = Op("&").Id("mo").Dot(f.GoName)
+		if f.Message != nil {
+			typ, err := tg.fieldGoType(f)
+			if err != nil {
+				return nil, err
+			}
+			pre = append(pre, Id("mo").Dot(f.GoName).Op("=").New(typ))
+			dst = Id("mo").Dot(f.GoName)
+		}
+	case f.Message != nil || (f.Desc.HasPresence() && f.Desc.Kind() != protoreflect.BytesKind):
+		typ, err := tg.fieldGoType(f)
+		if err != nil {
+			return nil, err
+		}
+		pre = append(pre, Id("x").Dot(f.GoName).Op("=").New(typ))
+		dst = Id("x").Dot(f.GoName)
+	}
+
+	return []Code{
+		If(Id("m").Index(Lit(tg.attrName(f))).Op("!=").Nil()).Block(append(append(pre,
+			Err().Op("=").Qual(tg.idents.ddb, "UnmarshalCodec").Call(
+				Lit(tg.codecName(f)),
+				Id("m").Index(Lit(tg.attrName(f))),
+				dst,
+			),
+			If(Err().Op("!=").Nil()).Block(
+				Return(Qual("fmt", "Errorf").Call(Lit("failed to unmarshal field '"+f.GoName+"': %w"), Err())),
+			)), post...)...,
+		),
+	}, nil
+}
+
+// genFieldUnmarshal generates unmarshal code for a field, depending on its kind
+func (tg *Target) genFieldUnmarshal(field *protogen.Field) ([]Code, error) {
+	switch {
+	case tg.codecName(field) != "": // fields with a codec are unmarshalled by it, whatever their kind
+		return tg.genCodecFieldUnmarshal(field)
+	case field.Oneof != nil && !field.Desc.HasOptionalKeyword():
+		// special case are explicit oneOf fields (not optional fields)
+		return tg.genOneOfFieldUnmarshal(field)
+	case field.Desc.IsList(): // repeated fields
+		return tg.genListFieldUnmarshal(field)
+	case field.Desc.IsMap(): // map
+		return tg.genMapFieldUnmarshal(field)
+	case field.Message != nil: // (nested) message
+		return tg.genMessageFieldUnmarshal(field)
+	default: // other, basic types
+		return tg.genBasicFieldUnmarshal(field), nil
 	}
 }
 
 // genMessageUnmarshal generates the unmarshaling logic
 func (tg *Target) genMessageUnmarshal(f *File, m *protogen.Message) error {
 	var body []Code
+	var errs []error
 
 	// generate unmarschalling code per field kind
 	for _, field := range m.Fields {
@@ -161,19 +249,16 @@ func (tg *Target) genMessageUnmarshal(f *File, m *protogen.Message) error {
 			continue // don't generate unmarshal code for omitted field
 		}
 
-		switch {
-		case field.Oneof != nil && !field.Desc.HasOptionalKeyword():
-			// special case are explicit oneOf fields (not optional fields)
-			body = append(body, tg.genOneOfFieldUnmarshal(field)...)
-		case field.Desc.IsList(): // repeated fields
-			body = append(body, tg.genListFieldUnmarshal(field)...)
-		case field.Desc.IsMap(): // map
-			body = append(body, tg.genMapFieldUnmarshal(field)...)
-		case field.Message != nil: // (nested) message
-			body = append(body, tg.genMessageFieldUnmarshal(field)...)
-		default: // other, basic types
-			body = append(body, tg.genBasicFieldUnmarshal(field)...)
+		c, err := tg.genFieldUnmarshal(field)
+		if err != nil {
+			errs = append(errs, sourceError(field.Desc, err))
+			continue
 		}
+		body = append(body, c...)
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
 	}
 
 	f.Comment(`UnmarshalDynamoItem unmarshals data from a dynamodb attribute map`)
@@ -0,0 +1,29 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ParseWellKnownPath parses the mapping of a message's full name to the path struct that fields holding
+// it use, formatted as 'google.type.Date=github.com/acme/ddbtypes.DatePath'.
+func ParseWellKnownPath(s string) (name protoreflect.FullName, ident protogen.GoIdent, err error) {
+	fname, qual, ok := strings.Cut(s, "=")
+	if !ok {
+		return name, ident, fmt.Errorf("well-known path '%s' is not formatted as <message>=<import path>.<type>", s)
+	}
+
+	i := strings.LastIndex(qual, ".")
+	if i < 0 || i < strings.LastIndex(qual, "/") {
+		return name, ident, fmt.Errorf("path struct '%s' is not formatted as <import path>.<type>", qual)
+	}
+
+	name = protoreflect.FullName(strings.TrimSpace(fname))
+	if !name.IsValid() {
+		return name, ident, fmt.Errorf("invalid message name '%s'", name)
+	}
+	return name, protogen.GoIdent{GoImportPath: protogen.GoImportPath(qual[:i]), GoName: qual[i+1:]}, nil
+}
@@ -0,0 +1,130 @@
+package generator_test
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb"
+	"github.com/crewlinker/protoc-gen-dynamodb/ddb/ddbpath"
+	"github.com/crewlinker/protoc-gen-dynamodb/internal/generator"
+	messagev1 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/message/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func init() {
+	ddbpath.RegisterWellKnown("example.message.v1.Amount", ddbpath.WellKnown{
+		Marshal: func(x proto.Message) (types.AttributeValue, error) {
+			a := x.(*messagev1.Amount)
+			return &types.AttributeValueMemberS{Value: fmt.Sprintf("%s %d", a.Currency, a.Cents)}, nil
+		},
+		Unmarshal: func(av types.AttributeValue, x proto.Message) error {
+			s, ok := av.(*types.AttributeValueMemberS)
+			if !ok {
+				return fmt.Errorf("unsupported attribute: %T", av)
+			}
+			a := x.(*messagev1.Amount)
+			_, err := fmt.Sscanf(s.Value, "%s %d", &a.Currency, &a.Cents)
+			return err
+		},
+		Path:          amountPath{},
+		AttributeType: expression.String,
+	})
+}
+
+// amountPath is the path to an amount, which is stored as a string
+type amountPath struct{ expression.NameBuilder }
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p amountPath) WithDynamoNameBuilder(n expression.NameBuilder) amountPath {
+	p.NameBuilder = n
+	return p
+}
+
+var _ = Describe("registered well-knowns", func() {
+	It("should marshal and unmarshal with the registered functions", func() {
+		in := &messagev1.Wallet{
+			Balance: &messagev1.Amount{Currency: "EUR", Cents: 1250},
+			Spent:   []*messagev1.Amount{{Currency: "USD", Cents: 5}},
+			Savings: map[string]*messagev1.Amount{"bike": {Currency: "EUR", Cents: 30000}},
+		}
+
+		item, err := in.MarshalDynamoItem()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item).To(Equal(map[string]types.AttributeValue{
+			"1": &types.AttributeValueMemberS{Value: "EUR 1250"},
+			"2": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+				&types.AttributeValueMemberS{Value: "USD 5"},
+			}},
+			"3": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+				"bike": &types.AttributeValueMemberS{Value: "EUR 30000"},
+			}},
+		}))
+
+		var out messagev1.Wallet
+		Expect(out.UnmarshalDynamoItem(item)).To(Succeed())
+		ExpectProtoEqual(&out, in)
+	})
+
+	It("should take precedence over the generated code", func() {
+		av, err := ddb.MarshalMessage(&messagev1.Amount{Currency: "EUR", Cents: 1})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(av).To(Equal(&types.AttributeValueMemberS{Value: "EUR 1"}))
+	})
+
+	It("should register the path type", func() {
+		typ, ok := ddbpath.WellKnownPath("example.message.v1.Amount")
+		Expect(ok).To(BeTrue())
+		Expect(typ).To(Equal(reflect.TypeOf(amountPath{})))
+
+		typ, ok = ddbpath.WellKnownPath("google.protobuf.Timestamp")
+		Expect(ok).To(BeTrue())
+		Expect(typ).To(Equal(reflect.TypeOf(ddbpath.TimestampPath{})))
+	})
+
+	It("should panic when registered twice", func() {
+		Expect(func() {
+			ddb.RegisterWellKnown("google.protobuf.Duration", nil, nil)
+		}).To(PanicWith("ddb: well-known 'google.protobuf.Duration' is already registered"))
+	})
+})
+
+// dynamic marshalling converts dynamic messages to the registered Go type of the well-known
+var _ = DescribeTable("dynamic parity well-knowns", func(in itemMessage) {
+	ExpectDynamicParity(in, dynamicDescriptor(in))
+},
+	Entry("zero", &messagev1.Wallet{}),
+	Entry("values", &messagev1.Wallet{
+		Balance: &messagev1.Amount{},
+		Spent:   []*messagev1.Amount{{Currency: "EUR", Cents: -1}},
+		Savings: map[string]*messagev1.Amount{"": {Currency: "USD"}},
+	}),
+)
+
+var _ = DescribeTable("parse well-known paths", func(s string, expName protoreflect.FullName, expIdent protogen.GoIdent, expErr string) {
+	name, ident, err := generator.ParseWellKnownPath(s)
+	if expErr != "" {
+		Expect(err).To(MatchError(expErr))
+		return
+	}
+
+	Expect(err).ToNot(HaveOccurred())
+	Expect(name).To(Equal(expName))
+	Expect(ident).To(Equal(expIdent))
+},
+	Entry("valid", "google.type.Date=github.com/acme/ddbtypes.DatePath", protoreflect.FullName("google.type.Date"),
+		protogen.GoIdent{GoImportPath: "github.com/acme/ddbtypes", GoName: "DatePath"}, ""),
+	Entry("dotted import path", "google.type.Date=gopkg.in/types.v1.DatePath", protoreflect.FullName("google.type.Date"),
+		protogen.GoIdent{GoImportPath: "gopkg.in/types.v1", GoName: "DatePath"}, ""),
+	Entry("no path struct", "google.type.Date", protoreflect.FullName(""), protogen.GoIdent{},
+		"well-known path 'google.type.Date' is not formatted as <message>=<import path>.<type>"),
+	Entry("no type", "google.type.Date=github.com/acme/ddbtypes", protoreflect.FullName(""), protogen.GoIdent{},
+		"path struct 'github.com/acme/ddbtypes' is not formatted as <import path>.<type>"),
+	Entry("invalid name", "google..Date=github.com/acme/ddbtypes.DatePath", protoreflect.FullName(""), protogen.GoIdent{},
+		"invalid message name 'google..Date'"),
+)
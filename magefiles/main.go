@@ -13,6 +13,8 @@ import (
 func Generate() error {
 	if err := sh.Run("buf", "generate",
 		"--path", "example/message",
+		"--path", "example/common",
+		"--path", "example/foreign",
 		"--path", "ddb",
 	); err != nil {
 		return err
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
+	"os"
 	"path"
 	"path/filepath"
 	"strconv"
@@ -11,9 +13,55 @@ import (
 	"go.uber.org/zap"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
+var (
+	lint        = flag.Bool("lint", false, "check the protos for DynamoDB best practices instead of generating code")
+	lintEnable  []generator.LintRule
+	lintErrors  []generator.LintRule
+	lintDisable []generator.LintRule
+
+	wellKnownPaths = map[protoreflect.FullName]protogen.GoIdent{}
+	pathPackages   []protogen.GoImportPath
+)
+
+func init() {
+	// protoc splits plugin parameters on commas, so rules are listed by repeating the parameter
+	flag.Func("lint_enable", "opt-in lint rule that is checked, or 'all', can be repeated", func(s string) error {
+		rules, err := generator.ParseLintRules(s)
+		lintEnable = append(lintEnable, rules...)
+		return err
+	})
+	flag.Func("lint_errors", "lint rule whose findings fail the run, or 'all', can be repeated", func(s string) error {
+		rules, err := generator.ParseLintRules(s)
+		lintErrors = append(lintErrors, rules...)
+		return err
+	})
+	flag.Func("lint_disable", "lint rule that is not checked, or 'all', can be repeated", func(s string) error {
+		rules, err := generator.ParseLintRules(s)
+		lintDisable = append(lintDisable, rules...)
+		return err
+	})
+	flag.Func("path_package",
+		"go import path of a package whose path building is generated by this plugin, can be repeated",
+		func(s string) error {
+			pathPackages = append(pathPackages, protogen.GoImportPath(s))
+			return nil
+		})
+	flag.Func("well_known_path",
+		"path struct of a message whose encoding is registered at runtime, as <message>=<import path>.<type>, can be repeated",
+		func(s string) error {
+			name, ident, err := generator.ParseWellKnownPath(s)
+			if err != nil {
+				return err
+			}
+			wellKnownPaths[name] = ident
+			return nil
+		})
+}
+
 func main() {
 	flag.Parse()
 	protogen.Options{
@@ -25,13 +73,18 @@ func main() {
 			return fmt.Errorf("failed to setup logging: %w", err)
 		}
 
-		opts := generator.Config{}
+		opts := generator.Config{
+			Lint: *lint, LintEnable: lintEnable, LintErrors: lintErrors, LintDisable: lintDisable,
+			WellKnownPaths: wellKnownPaths, PathPackages: pathPackages,
+		}
 
 		gen, err := generator.NewGenerator(logs, opts)
 		if err != nil {
 			return fmt.Errorf("failed to initialize generator: %w", err)
 		}
 
+		// generate every file, errors of all files are reported together so every problem shows in one run
+		var errs []error
 		for _, name := range gp.Request.FileToGenerate {
 			pf := gp.FilesByPath[name]
 			if len(pf.Messages) < 1 {
@@ -40,31 +93,41 @@ func main() {
 			}
 
 			logs.Info("found file with messages", zap.Int("num_messages", len(pf.Messages)))
-			ddbf := gp.NewGeneratedFile(fmt.Sprintf("%s.ddb.go", pf.GeneratedFilenamePrefix), pf.GoImportPath)
 
 			// generated file for typed document path in a sub directory for more expressiveness
 			pathPkgName := "ddbpath" // NOTE: this could be made customizalbe through options
+			pathImpName, _ := strconv.Unquote(pf.GoImportPath.String())
+			pathImpName = path.Join(pathImpName, pathPkgName)
+
+			// in lint mode no files are generated, findings are printed as warnings to stderr
+			if opts.Lint {
+				if err := gen.Lint(gen.CreateTarget(pf, pathImpName), pathPkgName, os.Stderr); err != nil {
+					errs = append(errs, err)
+				}
+				continue
+			}
+
+			ddbf := gp.NewGeneratedFile(fmt.Sprintf("%s.ddb.go", pf.GeneratedFilenamePrefix), pf.GoImportPath)
 			pathFp := filepath.Join(
 				filepath.Dir(pf.GeneratedFilenamePrefix),
 				pathPkgName,
 				fmt.Sprintf("%s.go", filepath.Base(pf.GeneratedFilenamePrefix)),
 			)
 
-			pathImpName, _ := strconv.Unquote(pf.GoImportPath.String())
-			pathImpName = path.Join(pathImpName, pathPkgName)
-
 			// init target, and generate components for it
+			// errors name the file and location, path building is skipped if the message logic failed
+			// since it would report the same problems again
 			tg := gen.CreateTarget(pf, pathImpName)
 			if err := tg.GenerateMessageLogic(ddbf); err != nil {
-				return fmt.Errorf("failed to generate message logic for '%s': %w", *pf.Proto.Name, err)
+				errs = append(errs, err)
+				continue
 			}
 
 			if err := tg.GeneratePathBuilding(gp.NewGeneratedFile(pathFp, pf.GoImportPath), pathPkgName); err != nil {
-				return fmt.Errorf("failed to generate path building code for '%s': %w", *pf.Proto.Name, err)
+				errs = append(errs, err)
 			}
-
 		}
 
-		return nil
+		return errors.Join(errs...)
 	})
 }
@@ -3,7 +3,9 @@ package main_test
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"testing"
@@ -32,5 +34,153 @@ var _ = Describe("e2e", func() {
 		Entry("multiple fields as sk", "multiple_fields_sk.proto", `field 'One' is already marked as SK`),
 		Entry("invalid type for pk", "pk_invalid_type.proto", `field 'Pk' must be a basic type that marshals to Number,String or Bytes to be a PK`),
 		Entry("invalid type for sk", "sk_invalid_type.proto", `field 'Sk' must be a basic type that marshals to Number,String or Bytes to be a SK`),
+		Entry("index sort key only", "gsi_sort_key_only.proto", `global secondary index 'by_two' has a sort key, but not a partition key`),
+		Entry("local index without pk", "lsi_without_pk.proto", `local secondary index 'by_two' requires the message to have a partition key`),
+		Entry("bool set", "bool_set.proto", `field 'Flags' of kind 'bool' cannot be a set`),
+		Entry("message set", "message_set.proto", `field 'Items' of kind 'message' cannot be a set`),
+		Entry("set on singular field", "singular_set.proto", `field 'Name' is marked as a set, but is not a repeated field`),
+		Entry("attribute name collisions", "attr_name_collision.proto", `(?s)`+
+			`attr_name_collision.proto:13:5: example.wrong.v1.AttrNameCollision.three: field 'Three' is stored as attribute '3', which is already the attribute of field 'Named'.*`+
+			`attr_name_collision.proto:15:5: example.wrong.v1.AttrNameCollision.id: field 'Id' is stored as attribute 'id', which is already the attribute of field 'Pk'`),
+		Entry("codec on a set", "codec_set.proto", `field 'Tags' has codec 'joined', so it cannot also be a set`),
+		Entry("codec on a key", "codec_key.proto", `field 'Pk' has codec 'cents', so it cannot be a key`),
+		Entry("location of the error", "bool_set.proto", `example/wrong/v1/bool_set.proto:9:5: example.wrong.v1.BoolSet.flags: field 'Flags'`),
+		Entry("all errors of a file", "multiple_errors.proto", `(?s)`+
+			`multiple_errors.proto:9:5: example.wrong.v1.MultipleErrors.flags: field 'Flags' of kind 'bool' cannot be a set.*`+
+			`multiple_errors.proto:11:5: example.wrong.v1.MultipleErrors.name: field 'Name' is marked as a set.*`+
+			`multiple_errors.proto:15:1: example.wrong.v1.OtherErrors: message 'OtherErrors' has a sort key`),
 	)
+
+	It("should report the errors of all files in one run", func(ctx context.Context) {
+		errb := bytes.NewBuffer(nil)
+		cmd := exec.CommandContext(ctx, "buf", "generate",
+			"--path", filepath.Join("example", "wrong", "v1", "bool_set.proto"),
+			"--path", filepath.Join("example", "wrong", "v1", "singular_set.proto"))
+		cmd.Stderr = io.MultiWriter(GinkgoWriter, errb)
+		Expect(cmd.Run()).ToNot(Succeed())
+		Expect(errb.String()).To(ContainSubstring(`bool_set.proto:9:5`))
+		Expect(errb.String()).To(ContainSubstring(`singular_set.proto:9:5`))
+	})
+
+	Describe("well-known paths", func() {
+		generate := func(ctx context.Context, opt string) (outDir, out string, err error) {
+			outDir = GinkgoT().TempDir()
+			tmpl := fmt.Sprintf(`{"version":"v1","plugins":[{"name":"dynamodb","out":%q,"opt":%q,"path":["go","run","-cover","."]}]}`, outDir, opt)
+
+			errb := bytes.NewBuffer(nil)
+			cmd := exec.CommandContext(ctx, "buf", "generate", "--template", tmpl,
+				"--path", filepath.Join("example", "message", "v1", "message.proto"))
+			cmd.Stderr = io.MultiWriter(GinkgoWriter, errb)
+			err = cmd.Run()
+			return outDir, errb.String(), err
+		}
+
+		It("should generate paths with the configured path struct", func(ctx context.Context) {
+			outDir, _, err := generate(ctx,
+				"paths=source_relative,well_known_path=example.message.v1.Amount=github.com/acme/ddbtypes.AmountPath")
+			Expect(err).ToNot(HaveOccurred())
+
+			src, err := os.ReadFile(filepath.Join(outDir, "example", "message", "v1", "ddbpath", "message.go"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(src)).To(ContainSubstring(`func (p WalletPath) Balance() ddbtypes.AmountPath {`))
+			Expect(string(src)).To(ContainSubstring(`func (p WalletPath) Spent() ddbpath.ItemList[ddbtypes.AmountPath] {`))
+			Expect(string(src)).To(ContainSubstring(`func (p WalletPath) Savings() ddbpath.ItemMap[ddbtypes.AmountPath] {`))
+			Expect(string(src)).To(ContainSubstring(`type AmountPath struct`)) // the message's own paths are still generated
+		})
+
+		It("should fail on a malformed option", func(ctx context.Context) {
+			_, out, err := generate(ctx, "paths=source_relative,well_known_path=example.message.v1.Amount")
+			Expect(err).To(HaveOccurred())
+			Expect(out).To(ContainSubstring(`is not formatted as <message>=<import path>.<type>`))
+		})
+	})
+
+	Describe("path packages", func() {
+		generate := func(ctx context.Context, opt string) (src string, err error) {
+			outDir := GinkgoT().TempDir()
+			tmpl := fmt.Sprintf(`{"version":"v1","plugins":[{"name":"dynamodb","out":%q,"opt":%q,"path":["go","run","-cover","."]}]}`, outDir, opt)
+
+			cmd := exec.CommandContext(ctx, "buf", "generate", "--template", tmpl,
+				"--path", filepath.Join("example", "message", "v1", "other.proto"))
+			cmd.Stderr = GinkgoWriter
+			if err = cmd.Run(); err != nil {
+				return "", err
+			}
+
+			srcb, err := os.ReadFile(filepath.Join(outDir, "example", "message", "v1", "ddbpath", "other.go"))
+			return string(srcb), err
+		}
+
+		It("should not assume path building for foreign messages", func(ctx context.Context) {
+			src, err := generate(ctx, "paths=source_relative")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(src).To(ContainSubstring(`func (p OtherKitchenPath) Birthday() expression.NameBuilder {`))
+			Expect(src).To(ContainSubstring(`func (p OtherKitchenPath) Holidays() ddbpath.List {`))
+			Expect(src).To(ContainSubstring(`func (p OtherKitchenPath) DatesByName() ddbpath.Map {`))
+			Expect(src).ToNot(ContainSubstring(`foreign/v1/ddbpath`))
+		})
+
+		It("should path into packages that are configured to have path building", func(ctx context.Context) {
+			src, err := generate(ctx,
+				"paths=source_relative,path_package=github.com/crewlinker/protoc-gen-dynamodb/proto/example/foreign/v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(src).To(ContainSubstring(`func (p OtherKitchenPath) Birthday() foreignv1ddbpath.DatePath {`))
+			Expect(src).To(ContainSubstring(`func (p OtherKitchenPath) Holidays() ddbpath.ItemList[foreignv1ddbpath.DatePath] {`))
+			Expect(src).To(ContainSubstring(`func (p OtherKitchenPath) DatesByName() ddbpath.ItemMap[foreignv1ddbpath.DatePath] {`))
+		})
+	})
+
+	Describe("lint mode", func() {
+		lint := func(ctx context.Context, opt string) (out string, err error) {
+			outDir := GinkgoT().TempDir()
+			tmpl := fmt.Sprintf(`{"version":"v1","plugins":[{"name":"dynamodb","out":%q,"opt":%q,"path":["go","run","-cover","."]}]}`, outDir, opt)
+
+			errb := bytes.NewBuffer(nil)
+			cmd := exec.CommandContext(ctx, "buf", "generate", "--template", tmpl,
+				"--path", filepath.Join("example", "wrong", "v1", "lint.proto"))
+			cmd.Stderr = io.MultiWriter(GinkgoWriter, errb)
+			err = cmd.Run()
+
+			entries, rerr := os.ReadDir(outDir)
+			Expect(rerr).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty()) // lint mode never generates code
+			return errb.String(), err
+		}
+
+		It("should print findings as warnings", func(ctx context.Context) {
+			out, err := lint(ctx, "lint=true")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(ContainSubstring(`lint.proto:7:1: example.wrong.v1.Linted: message 'Linted' is recursive through field 'example.wrong.v1.Linted.children'`))
+			Expect(out).To(ContainSubstring(`lint.proto:9:5: example.wrong.v1.Linted.pk: key field 'Pk' is a double`))
+			Expect(out).To(ContainSubstring(`lint.proto:11:5: example.wrong.v1.Linted.status: attribute name 'status' is a reserved word`))
+			Expect(out).ToNot(ContainSubstring(`(unbounded-repeated)`)) // opt-in
+		})
+
+		It("should check opt-in rules that are enabled", func(ctx context.Context) {
+			out, err := lint(ctx, "lint=true,lint_enable=unbounded-repeated")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(ContainSubstring(`field 'Children' holds any number of 'example.wrong.v1.Linted' messages`))
+		})
+
+		It("should fail on findings of rules that are errors", func(ctx context.Context) {
+			out, err := lint(ctx, "lint=true,lint_errors=float-key,lint_errors=deep-nesting")
+			Expect(err).To(HaveOccurred())
+			Expect(out).To(MatchRegexp(`(?m)^warning: .*\(reserved-name\)$`))
+			Expect(out).ToNot(MatchRegexp(`(?m)^warning: .*\(deep-nesting\)$`))
+			Expect(out).ToNot(MatchRegexp(`(?m)^warning: .*\(float-key\)$`))
+			Expect(out).To(ContainSubstring(`key field 'Pk' is a double, rounding makes floating point keys unreliable to look items up by (float-key)`))
+		})
+
+		It("should not check disabled rules", func(ctx context.Context) {
+			out, err := lint(ctx, "lint=true,lint_errors=all,lint_disable=all")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).ToNot(ContainSubstring("warning:"))
+		})
+
+		It("should fail on unknown rules", func(ctx context.Context) {
+			out, err := lint(ctx, "lint=true,lint_errors=bogus")
+			Expect(err).To(HaveOccurred())
+			Expect(out).To(ContainSubstring(`unknown lint rule 'bogus'`))
+		})
+	})
 })
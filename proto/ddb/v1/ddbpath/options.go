@@ -6,6 +6,8 @@ package ddbv1ddbpath
 import (
 	expression "github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
 	ddbpath "github.com/crewlinker/protoc-gen-dynamodb/ddb/ddbpath"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // FieldOptionsPath allows for constructing type-safe expression names
@@ -19,42 +21,136 @@ func (p FieldOptionsPath) WithDynamoNameBuilder(n expression.NameBuilder) FieldO
 	return p
 }
 
-// Name appends the path being build
-func (p FieldOptionsPath) Name() expression.NameBuilder {
-	return p.AppendName(expression.Name("1"))
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p FieldOptionsPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "ddb.v1.FieldOptions", x)
 }
 
-// Pk appends the path being build
-func (p FieldOptionsPath) Pk() expression.NameBuilder {
-	return p.AppendName(expression.Name("2"))
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p FieldOptionsPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "ddb.v1.FieldOptions", x)
 }
 
-// Sk appends the path being build
-func (p FieldOptionsPath) Sk() expression.NameBuilder {
-	return p.AppendName(expression.Name("3"))
+// DynamoMessageName returns the full name of the message at the path
+func (p FieldOptionsPath) DynamoMessageName() protoreflect.FullName {
+	return "ddb.v1.FieldOptions"
 }
 
-// Omit appends the path being build
-func (p FieldOptionsPath) Omit() expression.NameBuilder {
-	return p.AppendName(expression.Name("4"))
+// DynamoRemove returns an update that removes the message at the path
+func (p FieldOptionsPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
 }
 
-// Set appends the path being build
-func (p FieldOptionsPath) Set() expression.NameBuilder {
-	return p.AppendName(expression.Name("5"))
+// Name returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldOptionsPath) Name() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("1")))
 }
 
-// Embed appends the path being build
-func (p FieldOptionsPath) Embed() expression.NameBuilder {
-	return p.AppendName(expression.Name("6"))
+// Pk returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldOptionsPath) Pk() ddbpath.Bool {
+	return ddbpath.Bool{}.WithDynamoNameBuilder(p.AppendName(expression.Name("2")))
+}
+
+// Sk returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldOptionsPath) Sk() ddbpath.Bool {
+	return ddbpath.Bool{}.WithDynamoNameBuilder(p.AppendName(expression.Name("3")))
+}
+
+// Omit returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldOptionsPath) Omit() ddbpath.Bool {
+	return ddbpath.Bool{}.WithDynamoNameBuilder(p.AppendName(expression.Name("4")))
+}
+
+// Set returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldOptionsPath) Set() ddbpath.Bool {
+	return ddbpath.Bool{}.WithDynamoNameBuilder(p.AppendName(expression.Name("5")))
+}
+
+// Embed returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldOptionsPath) Embed() ddbpath.Enum[protoreflect.Enum] {
+	return ddbpath.Enum[protoreflect.Enum]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("6")))
+}
+
+// GsiPk returns 'p' appended with the attribute name and allow indexing typed values
+func (p FieldOptionsPath) GsiPk() ddbpath.StringList {
+	return ddbpath.StringList{}.WithDynamoNameBuilder(p.AppendName(expression.Name("7")))
+}
+
+// GsiSk returns 'p' appended with the attribute name and allow indexing typed values
+func (p FieldOptionsPath) GsiSk() ddbpath.StringList {
+	return ddbpath.StringList{}.WithDynamoNameBuilder(p.AppendName(expression.Name("8")))
+}
+
+// LsiSk returns 'p' appended with the attribute name and allow indexing typed values
+func (p FieldOptionsPath) LsiSk() ddbpath.StringList {
+	return ddbpath.StringList{}.WithDynamoNameBuilder(p.AppendName(expression.Name("9")))
+}
+
+// Codec returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldOptionsPath) Codec() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("10")))
 }
 func init() {
 	ddbpath.Register(FieldOptionsPath{}, map[string]ddbpath.FieldInfo{
-		"1": {Kind: ddbpath.FieldKindSingle},
-		"2": {Kind: ddbpath.FieldKindSingle},
-		"3": {Kind: ddbpath.FieldKindSingle},
-		"4": {Kind: ddbpath.FieldKindSingle},
-		"5": {Kind: ddbpath.FieldKindSingle},
-		"6": {Kind: ddbpath.FieldKindSingle},
+		"1": {
+			AttributeType: expression.String,
+			FullName:      "ddb.v1.FieldOptions.name",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"10": {
+			AttributeType: expression.String,
+			FullName:      "ddb.v1.FieldOptions.codec",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"2": {
+			AttributeType: expression.Boolean,
+			FullName:      "ddb.v1.FieldOptions.pk",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"3": {
+			AttributeType: expression.Boolean,
+			FullName:      "ddb.v1.FieldOptions.sk",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"4": {
+			AttributeType: expression.Boolean,
+			FullName:      "ddb.v1.FieldOptions.omit",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"5": {
+			AttributeType: expression.Boolean,
+			FullName:      "ddb.v1.FieldOptions.set",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"6": {
+			AttributeType: expression.Number,
+			FullName:      "ddb.v1.FieldOptions.embed",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"7": {
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.String,
+			FullName:          "ddb.v1.FieldOptions.gsi_pk",
+			Kind:              ddbpath.FieldKindList,
+		},
+		"8": {
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.String,
+			FullName:          "ddb.v1.FieldOptions.gsi_sk",
+			Kind:              ddbpath.FieldKindList,
+		},
+		"9": {
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.String,
+			FullName:          "ddb.v1.FieldOptions.lsi_sk",
+			Kind:              ddbpath.FieldKindList,
+		},
 	})
 }
@@ -102,6 +102,14 @@ type FieldOptions struct {
 	Set *bool `protobuf:"varint,5,opt,name=set" json:"set,omitempty"`
 	// allows for embedding the field's value as an encoded json or binary protobuf
 	Embed *Encoding `protobuf:"varint,6,opt,name=embed,enum=ddb.v1.Encoding" json:"embed,omitempty"`
+	// names of the global secondary indexes for which the field is the partition key
+	GsiPk []string `protobuf:"bytes,7,rep,name=gsi_pk,json=gsiPk" json:"gsi_pk,omitempty"`
+	// names of the global secondary indexes for which the field is the sort key
+	GsiSk []string `protobuf:"bytes,8,rep,name=gsi_sk,json=gsiSk" json:"gsi_sk,omitempty"`
+	// names of the local secondary indexes for which the field is the sort key
+	LsiSk []string `protobuf:"bytes,9,rep,name=lsi_sk,json=lsiSk" json:"lsi_sk,omitempty"`
+	// name of the codec, registered with ddb.RegisterCodec, that marshals and unmarshals the field's value
+	Codec *string `protobuf:"bytes,10,opt,name=codec" json:"codec,omitempty"`
 }
 
 func (x *FieldOptions) Reset() {
@@ -178,6 +186,34 @@ func (x *FieldOptions) GetEmbed() Encoding {
 	return Encoding_ENCODING_UNSPECIFIED
 }
 
+func (x *FieldOptions) GetGsiPk() []string {
+	if x != nil {
+		return x.GsiPk
+	}
+	return nil
+}
+
+func (x *FieldOptions) GetGsiSk() []string {
+	if x != nil {
+		return x.GsiSk
+	}
+	return nil
+}
+
+func (x *FieldOptions) GetLsiSk() []string {
+	if x != nil {
+		return x.LsiSk
+	}
+	return nil
+}
+
+func (x *FieldOptions) GetCodec() string {
+	if x != nil && x.Codec != nil {
+		return *x.Codec
+	}
+	return ""
+}
+
 var file_ddb_v1_options_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
@@ -202,7 +238,7 @@ var file_ddb_v1_options_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x64, 0x64, 0x62, 0x2e, 0x76, 0x31, 0x1a, 0x20,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
 	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x90, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xeb, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x02, 0x70, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
@@ -211,26 +247,31 @@ var file_ddb_v1_options_proto_rawDesc = []byte{
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x65,
 	0x6d, 0x62, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x64, 0x64, 0x62,
 	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x65, 0x6d,
-	0x62, 0x65, 0x64, 0x2a, 0x4c, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12,
-	0x18, 0x0a, 0x14, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x43,
-	0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
-	0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x59, 0x4e, 0x41, 0x4d, 0x4f, 0x10,
-	0x02, 0x3a, 0x4a, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xca, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x64, 0x64, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x91, 0x01,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x64, 0x62, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x72, 0x65, 0x77, 0x6c, 0x69, 0x6e,
-	0x6b, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x64,
-	0x79, 0x6e, 0x61, 0x6d, 0x6f, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x64,
-	0x62, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x64, 0x62, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58,
-	0xaa, 0x02, 0x06, 0x44, 0x64, 0x62, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x64, 0x62, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x12, 0x44, 0x64, 0x62, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x64, 0x62, 0x3a, 0x3a, 0x56,
-	0x31,
+	0x62, 0x65, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x73, 0x69, 0x5f, 0x70, 0x6b, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x67, 0x73, 0x69, 0x50, 0x6b, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x73,
+	0x69, 0x5f, 0x73, 0x6b, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x67, 0x73, 0x69, 0x53,
+	0x6b, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x73, 0x69, 0x5f, 0x73, 0x6b, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x73, 0x69, 0x53, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65,
+	0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2a, 0x4c,
+	0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4e,
+	0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47,
+	0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x4e, 0x43, 0x4f, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x44, 0x59, 0x4e, 0x41, 0x4d, 0x4f, 0x10, 0x02, 0x3a, 0x4a, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xca, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x64,
+	0x62, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x91, 0x01, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x2e, 0x64, 0x64, 0x62, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x72, 0x65, 0x77, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x6f,
+	0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x64, 0x62, 0x2f, 0x76, 0x31, 0x3b,
+	0x64, 0x64, 0x62, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x44, 0x64,
+	0x62, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x64, 0x62, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12,
+	0x44, 0x64, 0x62, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x64, 0x62, 0x3a, 0x3a, 0x56, 0x31,
 }
 
 var (
@@ -0,0 +1,114 @@
+// Code generated by protoc-gen-dynamodb. DO NOT EDIT.
+
+package commonv1
+
+import (
+	"fmt"
+	types "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	ddb "github.com/crewlinker/protoc-gen-dynamodb/ddb"
+	v1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+)
+
+// MarshalDynamoItem marshals data into a dynamodb attribute map
+func (x *Address) MarshalDynamoItem() (m map[string]types.AttributeValue, err error) {
+	m = make(map[string]types.AttributeValue)
+	if x.Street != "" {
+		m["1"], err = ddb.Marshal(x.GetStreet(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Street': %w", err)
+		}
+	}
+	if x.City != "" {
+		m["c"], err = ddb.Marshal(x.GetCity(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'City': %w", err)
+		}
+	}
+	if len(x.Tags) != 0 {
+		m["3"], err = ddb.Marshal(x.GetTags(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Tags': %w", err)
+		}
+	}
+	if x.Geo != nil {
+		m4, err := ddb.MarshalMessage(x.GetGeo(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Geo': %w", err)
+		}
+		m["4"] = m4
+	}
+	return m, nil
+}
+
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *Address) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
+// UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
+func (x *Address) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
+	err = ddb.Unmarshal(m["1"], &x.Street, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Street': %w", err)
+	}
+	err = ddb.Unmarshal(m["c"], &x.City, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'City': %w", err)
+	}
+	err = ddb.Unmarshal(m["3"], &x.Tags, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Tags': %w", err)
+	}
+	if m["4"] != nil {
+		x.Geo = new(Geo)
+		err = ddb.UnmarshalMessage(m["4"], x.Geo, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'Geo': %w", err)
+		}
+	}
+	return nil
+}
+
+// MarshalDynamoItem marshals data into a dynamodb attribute map
+func (x *Geo) MarshalDynamoItem() (m map[string]types.AttributeValue, err error) {
+	m = make(map[string]types.AttributeValue)
+	if x.Lat != 0 {
+		m["1"], err = ddb.Marshal(x.GetLat(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Lat': %w", err)
+		}
+	}
+	if x.Lng != 0 {
+		m["2"], err = ddb.Marshal(x.GetLng(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Lng': %w", err)
+		}
+	}
+	return m, nil
+}
+
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *Geo) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
+// UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
+func (x *Geo) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
+	err = ddb.Unmarshal(m["1"], &x.Lat, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Lat': %w", err)
+	}
+	err = ddb.Unmarshal(m["2"], &x.Lng, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Lng': %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-dynamodb. DO NOT EDIT.
+
+// Package commonv1ddbpath holds generated code for working with Dynamo document paths
+package commonv1ddbpath
+
+import (
+	expression "github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	ddbpath "github.com/crewlinker/protoc-gen-dynamodb/ddb/ddbpath"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	"reflect"
+)
+
+// AddressPath allows for constructing type-safe expression names
+type AddressPath struct {
+	expression.NameBuilder
+}
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p AddressPath) WithDynamoNameBuilder(n expression.NameBuilder) AddressPath {
+	p.NameBuilder = n
+	return p
+}
+
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p AddressPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.common.v1.Address", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p AddressPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.common.v1.Address", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p AddressPath) DynamoMessageName() protoreflect.FullName {
+	return "example.common.v1.Address"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p AddressPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// Street returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p AddressPath) Street() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("1")))
+}
+
+// City returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p AddressPath) City() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("c")))
+}
+
+// Tags returns 'p' appended with the attribute name and allow indexing typed values
+func (p AddressPath) Tags() ddbpath.StringList {
+	return ddbpath.StringList{}.WithDynamoNameBuilder(p.AppendName(expression.Name("3")))
+}
+
+// Geo returns 'p' with the attribute name appended and allow subselecting nested message
+func (p AddressPath) Geo() GeoPath {
+	return GeoPath{NameBuilder: p.AppendName(expression.Name("4"))}
+}
+func init() {
+	ddbpath.Register(AddressPath{}, map[string]ddbpath.FieldInfo{
+		"1": {
+			AttributeType: expression.String,
+			FullName:      "example.common.v1.Address.street",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"3": {
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.String,
+			FullName:          "example.common.v1.Address.tags",
+			Kind:              ddbpath.FieldKindList,
+		},
+		"4": {
+			AttributeType: expression.Map,
+			FullName:      "example.common.v1.Address.geo",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(GeoPath{}),
+			Presence:      true,
+		},
+		"c": {
+			AttributeType: expression.String,
+			FullName:      "example.common.v1.Address.city",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+	})
+}
+
+// GeoPath allows for constructing type-safe expression names
+type GeoPath struct {
+	expression.NameBuilder
+}
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p GeoPath) WithDynamoNameBuilder(n expression.NameBuilder) GeoPath {
+	p.NameBuilder = n
+	return p
+}
+
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p GeoPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.common.v1.Geo", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p GeoPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.common.v1.Geo", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p GeoPath) DynamoMessageName() protoreflect.FullName {
+	return "example.common.v1.Geo"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p GeoPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// Lat returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p GeoPath) Lat() ddbpath.Number[float64] {
+	return ddbpath.Number[float64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("1")))
+}
+
+// Lng returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p GeoPath) Lng() ddbpath.Number[float64] {
+	return ddbpath.Number[float64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("2")))
+}
+func init() {
+	ddbpath.Register(GeoPath{}, map[string]ddbpath.FieldInfo{
+		"1": {
+			AttributeType: expression.Number,
+			FullName:      "example.common.v1.Geo.lat",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"2": {
+			AttributeType: expression.Number,
+			FullName:      "example.common.v1.Geo.lng",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+	})
+}
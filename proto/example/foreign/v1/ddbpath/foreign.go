@@ -0,0 +1,76 @@
+// Code generated by protoc-gen-dynamodb. DO NOT EDIT.
+
+// Package foreignv1ddbpath holds generated code for working with Dynamo document paths
+package foreignv1ddbpath
+
+import (
+	expression "github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	ddbpath "github.com/crewlinker/protoc-gen-dynamodb/ddb/ddbpath"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DatePath allows for constructing type-safe expression names
+type DatePath struct {
+	expression.NameBuilder
+}
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p DatePath) WithDynamoNameBuilder(n expression.NameBuilder) DatePath {
+	p.NameBuilder = n
+	return p
+}
+
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p DatePath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.foreign.v1.Date", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p DatePath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.foreign.v1.Date", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p DatePath) DynamoMessageName() protoreflect.FullName {
+	return "example.foreign.v1.Date"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p DatePath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// Year returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p DatePath) Year() ddbpath.Number[int32] {
+	return ddbpath.Number[int32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("1")))
+}
+
+// Month returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p DatePath) Month() ddbpath.Number[int32] {
+	return ddbpath.Number[int32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("2")))
+}
+
+// Day returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p DatePath) Day() ddbpath.Number[int32] {
+	return ddbpath.Number[int32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("3")))
+}
+func init() {
+	ddbpath.Register(DatePath{}, map[string]ddbpath.FieldInfo{
+		"1": {
+			AttributeType: expression.Number,
+			FullName:      "example.foreign.v1.Date.year",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"2": {
+			AttributeType: expression.Number,
+			FullName:      "example.foreign.v1.Date.month",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"3": {
+			AttributeType: expression.Number,
+			FullName:      "example.foreign.v1.Date.day",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+	})
+}
@@ -6,6 +6,9 @@ package messagev1ddbpath
 import (
 	expression "github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
 	ddbpath "github.com/crewlinker/protoc-gen-dynamodb/ddb/ddbpath"
+	v1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	"reflect"
 )
 
@@ -20,19 +23,47 @@ func (p EnginePath) WithDynamoNameBuilder(n expression.NameBuilder) EnginePath {
 	return p
 }
 
-// Brand appends the path being build
-func (p EnginePath) Brand() expression.NameBuilder {
-	return p.AppendName(expression.Name("1"))
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p EnginePath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.Engine", x)
 }
 
-// Dirtyness appends the path being build
-func (p EnginePath) Dirtyness() expression.NameBuilder {
-	return p.AppendName(expression.Name("2"))
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p EnginePath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.Engine", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p EnginePath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.Engine"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p EnginePath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// Brand returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p EnginePath) Brand() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("1")))
+}
+
+// Dirtyness returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p EnginePath) Dirtyness() ddbpath.Enum[protoreflect.Enum] {
+	return ddbpath.Enum[protoreflect.Enum]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("2")))
 }
 func init() {
 	ddbpath.Register(EnginePath{}, map[string]ddbpath.FieldInfo{
-		"1": {Kind: ddbpath.FieldKindSingle},
-		"2": {Kind: ddbpath.FieldKindSingle},
+		"1": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.Engine.brand",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"2": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.Engine.dirtyness",
+			Kind:          ddbpath.FieldKindSingle,
+		},
 	})
 }
 
@@ -47,28 +78,59 @@ func (p CarPath) WithDynamoNameBuilder(n expression.NameBuilder) CarPath {
 	return p
 }
 
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p CarPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.Car", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p CarPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.Car", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p CarPath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.Car"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p CarPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
 // Engine returns 'p' with the attribute name appended and allow subselecting nested message
 func (p CarPath) Engine() EnginePath {
 	return EnginePath{NameBuilder: p.AppendName(expression.Name("1"))}
 }
 
-// NrOfWheels appends the path being build
-func (p CarPath) NrOfWheels() expression.NameBuilder {
-	return p.AppendName(expression.Name("ws"))
+// NrOfWheels returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p CarPath) NrOfWheels() ddbpath.Number[int64] {
+	return ddbpath.Number[int64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("ws")))
 }
 
-// Name appends the path being build
-func (p CarPath) Name() expression.NameBuilder {
-	return p.AppendName(expression.Name("2"))
+// Name returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p CarPath) Name() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("2")))
 }
 func init() {
 	ddbpath.Register(CarPath{}, map[string]ddbpath.FieldInfo{
 		"1": {
-			Kind:    ddbpath.FieldKindSingle,
-			Message: reflect.TypeOf(EnginePath{}),
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.Car.engine",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(EnginePath{}),
+			Presence:      true,
+		},
+		"2": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.Car.name",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"ws": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.Car.nr_of_wheels",
+			Kind:          ddbpath.FieldKindSingle,
 		},
-		"2":  {Kind: ddbpath.FieldKindSingle},
-		"ws": {Kind: ddbpath.FieldKindSingle},
 	})
 }
 
@@ -87,6 +149,11 @@ func Car() CarPath {
 	return CarPath{}
 }
 
+// CarKeyCond returns a key condition builder for the items with partition key 'nrOfWheels'
+func CarKeyCond(nrOfWheels int64) ddbpath.PartitionKeyCond {
+	return ddbpath.NewPartitionKeyCond("", "ws", nrOfWheels)
+}
+
 // CarKeyNames returns the attribute names of the partition and sort keys respectively
 func CarKeyNames() (v []string) {
 	v = append(v, "ws")
@@ -104,12 +171,36 @@ func (p AppliancePath) WithDynamoNameBuilder(n expression.NameBuilder) Appliance
 	return p
 }
 
-// Brand appends the path being build
-func (p AppliancePath) Brand() expression.NameBuilder {
-	return p.AppendName(expression.Name("1"))
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p AppliancePath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.Appliance", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p AppliancePath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.Appliance", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p AppliancePath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.Appliance"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p AppliancePath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// Brand returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p AppliancePath) Brand() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("1")))
 }
 func init() {
-	ddbpath.Register(AppliancePath{}, map[string]ddbpath.FieldInfo{"1": {Kind: ddbpath.FieldKindSingle}})
+	ddbpath.Register(AppliancePath{}, map[string]ddbpath.FieldInfo{"1": {
+		AttributeType: expression.String,
+		FullName:      "example.message.v1.Appliance.brand",
+		Kind:          ddbpath.FieldKindSingle,
+	}})
 }
 
 // IgnoredPath allows for constructing type-safe expression names
@@ -123,12 +214,36 @@ func (p IgnoredPath) WithDynamoNameBuilder(n expression.NameBuilder) IgnoredPath
 	return p
 }
 
-// Visible appends the path being build
-func (p IgnoredPath) Visible() expression.NameBuilder {
-	return p.AppendName(expression.Name("4"))
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p IgnoredPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.Ignored", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p IgnoredPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.Ignored", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p IgnoredPath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.Ignored"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p IgnoredPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// Visible returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p IgnoredPath) Visible() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("4")))
 }
 func init() {
-	ddbpath.Register(IgnoredPath{}, map[string]ddbpath.FieldInfo{"4": {Kind: ddbpath.FieldKindSingle}})
+	ddbpath.Register(IgnoredPath{}, map[string]ddbpath.FieldInfo{"4": {
+		AttributeType: expression.String,
+		FullName:      "example.message.v1.Ignored.visible",
+		Kind:          ddbpath.FieldKindSingle,
+	}})
 }
 
 // KitchenPath allows for constructing type-safe expression names
@@ -142,64 +257,84 @@ func (p KitchenPath) WithDynamoNameBuilder(n expression.NameBuilder) KitchenPath
 	return p
 }
 
-// Brand appends the path being build
-func (p KitchenPath) Brand() expression.NameBuilder {
-	return p.AppendName(expression.Name("1"))
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p KitchenPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.Kitchen", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p KitchenPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.Kitchen", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p KitchenPath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.Kitchen"
 }
 
-// IsRenovated appends the path being build
-func (p KitchenPath) IsRenovated() expression.NameBuilder {
-	return p.AppendName(expression.Name("2"))
+// DynamoRemove returns an update that removes the message at the path
+func (p KitchenPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
 }
 
-// QrCode appends the path being build
-func (p KitchenPath) QrCode() expression.NameBuilder {
-	return p.AppendName(expression.Name("3"))
+// Brand returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) Brand() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("1")))
 }
 
-// NumSmallKnifes appends the path being build
-func (p KitchenPath) NumSmallKnifes() expression.NameBuilder {
-	return p.AppendName(expression.Name("4"))
+// IsRenovated returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) IsRenovated() ddbpath.Bool {
+	return ddbpath.Bool{}.WithDynamoNameBuilder(p.AppendName(expression.Name("2")))
 }
 
-// NumSharpKnifes appends the path being build
-func (p KitchenPath) NumSharpKnifes() expression.NameBuilder {
-	return p.AppendName(expression.Name("5"))
+// QrCode returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) QrCode() ddbpath.Bytes {
+	return ddbpath.Bytes{}.WithDynamoNameBuilder(p.AppendName(expression.Name("3")))
 }
 
-// NumBluntKnifes appends the path being build
-func (p KitchenPath) NumBluntKnifes() expression.NameBuilder {
-	return p.AppendName(expression.Name("6"))
+// NumSmallKnifes returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) NumSmallKnifes() ddbpath.Number[int32] {
+	return ddbpath.Number[int32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("4")))
 }
 
-// NumSmallForks appends the path being build
-func (p KitchenPath) NumSmallForks() expression.NameBuilder {
-	return p.AppendName(expression.Name("7"))
+// NumSharpKnifes returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) NumSharpKnifes() ddbpath.Number[uint32] {
+	return ddbpath.Number[uint32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("5")))
 }
 
-// NumMediumForks appends the path being build
-func (p KitchenPath) NumMediumForks() expression.NameBuilder {
-	return p.AppendName(expression.Name("8"))
+// NumBluntKnifes returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) NumBluntKnifes() ddbpath.Number[uint32] {
+	return ddbpath.Number[uint32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("6")))
 }
 
-// NumLargeForks appends the path being build
-func (p KitchenPath) NumLargeForks() expression.NameBuilder {
-	return p.AppendName(expression.Name("9"))
+// NumSmallForks returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) NumSmallForks() ddbpath.Number[int64] {
+	return ddbpath.Number[int64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("7")))
 }
 
-// PercentBlackTiles appends the path being build
-func (p KitchenPath) PercentBlackTiles() expression.NameBuilder {
-	return p.AppendName(expression.Name("10"))
+// NumMediumForks returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) NumMediumForks() ddbpath.Number[uint64] {
+	return ddbpath.Number[uint64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("8")))
 }
 
-// PercentWhiteTiles appends the path being build
-func (p KitchenPath) PercentWhiteTiles() expression.NameBuilder {
-	return p.AppendName(expression.Name("11"))
+// NumLargeForks returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) NumLargeForks() ddbpath.Number[uint64] {
+	return ddbpath.Number[uint64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("9")))
 }
 
-// Dirtyness appends the path being build
-func (p KitchenPath) Dirtyness() expression.NameBuilder {
-	return p.AppendName(expression.Name("12"))
+// PercentBlackTiles returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) PercentBlackTiles() ddbpath.Number[float32] {
+	return ddbpath.Number[float32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("10")))
+}
+
+// PercentWhiteTiles returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) PercentWhiteTiles() ddbpath.Number[float64] {
+	return ddbpath.Number[float64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("11")))
+}
+
+// Dirtyness returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) Dirtyness() ddbpath.Enum[protoreflect.Enum] {
+	return ddbpath.Enum[protoreflect.Enum]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("12")))
 }
 
 // Furniture returns 'p' appended with the attribute while allow map keys on a nested message
@@ -207,9 +342,9 @@ func (p KitchenPath) Furniture() ddbpath.ItemMap[AppliancePath] {
 	return ddbpath.ItemMap[AppliancePath]{NameBuilder: p.AppendName(expression.Name("13"))}
 }
 
-// Calendar returns 'p' appended with the attribute name and allow map keys to be specified
-func (p KitchenPath) Calendar() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("14"))}
+// Calendar returns 'p' appended with the attribute name and allow map keys of typed values
+func (p KitchenPath) Calendar() ddbpath.ItemMap[ddbpath.Number[int64]] {
+	return ddbpath.ItemMap[ddbpath.Number[int64]]{NameBuilder: p.AppendName(expression.Name("14"))}
 }
 
 // WasherEngine returns 'p' with the attribute name appended and allow subselecting nested message
@@ -222,14 +357,14 @@ func (p KitchenPath) ExtraKitchen() KitchenPath {
 	return KitchenPath{NameBuilder: p.AppendName(expression.Name("16"))}
 }
 
-// Timer appends the path being build
-func (p KitchenPath) Timer() expression.NameBuilder {
-	return p.AppendName(expression.Name("17"))
+// Timer returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) Timer() ddbpath.DurationPath {
+	return ddbpath.DurationPath{NameBuilder: p.AppendName(expression.Name("17"))}
 }
 
-// WallTime appends the path being build
-func (p KitchenPath) WallTime() expression.NameBuilder {
-	return p.AppendName(expression.Name("18"))
+// WallTime returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) WallTime() ddbpath.TimestampPath {
+	return ddbpath.TimestampPath{NameBuilder: p.AppendName(expression.Name("18"))}
 }
 
 // ApplianceEngines returns 'p' appended with the attribute while allow indexing a nested message
@@ -237,9 +372,9 @@ func (p KitchenPath) ApplianceEngines() ddbpath.ItemList[EnginePath] {
 	return ddbpath.ItemList[EnginePath]{NameBuilder: p.AppendName(expression.Name("19"))}
 }
 
-// OtherBrands returns 'p' appended with the attribute name and allow indexing
-func (p KitchenPath) OtherBrands() ddbpath.List {
-	return ddbpath.List{NameBuilder: p.AppendName(expression.Name("20"))}
+// OtherBrands returns 'p' appended with the attribute name and allow indexing typed values
+func (p KitchenPath) OtherBrands() ddbpath.StringList {
+	return ddbpath.StringList{}.WithDynamoNameBuilder(p.AppendName(expression.Name("20")))
 }
 
 // SomeAny returns 'p' with the attribute name appended and allow subselecting nested message
@@ -257,39 +392,39 @@ func (p KitchenPath) SomeValue() ddbpath.ValuePath {
 	return ddbpath.ValuePath{NameBuilder: p.AppendName(expression.Name("23"))}
 }
 
-// OptString appends the path being build
-func (p KitchenPath) OptString() expression.NameBuilder {
-	return p.AppendName(expression.Name("24"))
+// OptString returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) OptString() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("24")))
 }
 
-// ValStr appends the path being build
-func (p KitchenPath) ValStr() expression.NameBuilder {
-	return p.AppendName(expression.Name("25"))
+// ValStr returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) ValStr() ddbpath.StringValuePath {
+	return ddbpath.StringValuePath{NameBuilder: p.AppendName(expression.Name("25"))}
 }
 
-// ValBytes appends the path being build
-func (p KitchenPath) ValBytes() expression.NameBuilder {
-	return p.AppendName(expression.Name("26"))
+// ValBytes returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p KitchenPath) ValBytes() ddbpath.BytesValuePath {
+	return ddbpath.BytesValuePath{NameBuilder: p.AppendName(expression.Name("26"))}
 }
 
-// ListOfTs returns 'p' appended with the attribute name and allow indexing
-func (p KitchenPath) ListOfTs() ddbpath.List {
-	return ddbpath.List{NameBuilder: p.AppendName(expression.Name("27"))}
+// ListOfTs returns 'p' appended with the attribute while allow indexing a nested message
+func (p KitchenPath) ListOfTs() ddbpath.ItemList[ddbpath.TimestampPath] {
+	return ddbpath.ItemList[ddbpath.TimestampPath]{NameBuilder: p.AppendName(expression.Name("27"))}
 }
 
-// StringSet returns 'p' appended with the attribute name and allow indexing
-func (p KitchenPath) StringSet() ddbpath.List {
-	return ddbpath.List{NameBuilder: p.AppendName(expression.Name("28"))}
+// StringSet returns 'p' appended with the attribute name and allow updating the set
+func (p KitchenPath) StringSet() ddbpath.StringSet {
+	return ddbpath.StringSet{}.WithDynamoNameBuilder(p.AppendName(expression.Name("28")))
 }
 
-// NumberSet returns 'p' appended with the attribute name and allow indexing
-func (p KitchenPath) NumberSet() ddbpath.List {
-	return ddbpath.List{NameBuilder: p.AppendName(expression.Name("29"))}
+// NumberSet returns 'p' appended with the attribute name and allow updating the set
+func (p KitchenPath) NumberSet() ddbpath.ValueSet[int64] {
+	return ddbpath.ValueSet[int64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("29")))
 }
 
-// BytesSet returns 'p' appended with the attribute name and allow indexing
-func (p KitchenPath) BytesSet() ddbpath.List {
-	return ddbpath.List{NameBuilder: p.AppendName(expression.Name("30"))}
+// BytesSet returns 'p' appended with the attribute name and allow updating the set
+func (p KitchenPath) BytesSet() ddbpath.ValueSet[[]byte] {
+	return ddbpath.ValueSet[[]byte]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("30")))
 }
 
 // RepeatedAny returns 'p' appended with the attribute while allow indexing a nested message
@@ -313,76 +448,221 @@ func (p KitchenPath) MappedFmask() ddbpath.ItemMap[ddbpath.FieldMaskPath] {
 }
 func init() {
 	ddbpath.Register(KitchenPath{}, map[string]ddbpath.FieldInfo{
-		"1":  {Kind: ddbpath.FieldKindSingle},
-		"10": {Kind: ddbpath.FieldKindSingle},
-		"11": {Kind: ddbpath.FieldKindSingle},
-		"12": {Kind: ddbpath.FieldKindSingle},
+		"1": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.Kitchen.brand",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"10": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.Kitchen.percent_black_tiles",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"11": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.Kitchen.percent_white_tiles",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"12": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.Kitchen.dirtyness",
+			Kind:          ddbpath.FieldKindSingle,
+		},
 		"13": {
-			Kind:    ddbpath.FieldKindMap,
-			Message: reflect.TypeOf(AppliancePath{}),
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.Kitchen.furniture",
+			Kind:              ddbpath.FieldKindMap,
+			Message:           reflect.TypeOf(AppliancePath{}),
+		},
+		"14": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.Kitchen.calendar",
+			Kind:              ddbpath.FieldKindMap,
 		},
-		"14": {Kind: ddbpath.FieldKindMap},
 		"15": {
-			Kind:    ddbpath.FieldKindSingle,
-			Message: reflect.TypeOf(EnginePath{}),
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.Kitchen.washer_engine",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(EnginePath{}),
+			Presence:      true,
 		},
 		"16": {
-			Kind:    ddbpath.FieldKindSingle,
-			Message: reflect.TypeOf(KitchenPath{}),
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.Kitchen.extra_kitchen",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(KitchenPath{}),
+			Presence:      true,
+		},
+		"17": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.Kitchen.timer",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"18": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.Kitchen.wall_time",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
 		},
-		"17": {Kind: ddbpath.FieldKindSingle},
-		"18": {Kind: ddbpath.FieldKindSingle},
 		"19": {
-			Kind:    ddbpath.FieldKindList,
-			Message: reflect.TypeOf(EnginePath{}),
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.Kitchen.appliance_engines",
+			Kind:              ddbpath.FieldKindList,
+			Message:           reflect.TypeOf(EnginePath{}),
+		},
+		"2": {
+			AttributeType: expression.Boolean,
+			FullName:      "example.message.v1.Kitchen.is_renovated",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"20": {
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.String,
+			FullName:          "example.message.v1.Kitchen.other_brands",
+			Kind:              ddbpath.FieldKindList,
 		},
-		"2":  {Kind: ddbpath.FieldKindSingle},
-		"20": {Kind: ddbpath.FieldKindList},
 		"21": {
-			Kind:    ddbpath.FieldKindSingle,
-			Message: reflect.TypeOf(ddbpath.AnyPath{}),
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.Kitchen.some_any",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(ddbpath.AnyPath{}),
+			Presence:      true,
 		},
 		"22": {
-			Kind:    ddbpath.FieldKindSingle,
-			Message: reflect.TypeOf(ddbpath.FieldMaskPath{}),
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.Kitchen.some_mask",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(ddbpath.FieldMaskPath{}),
+			Presence:      true,
 		},
 		"23": {
-			Kind:    ddbpath.FieldKindSingle,
-			Message: reflect.TypeOf(ddbpath.ValuePath{}),
-		},
-		"24": {Kind: ddbpath.FieldKindSingle},
-		"25": {Kind: ddbpath.FieldKindSingle},
-		"26": {Kind: ddbpath.FieldKindSingle},
-		"27": {Kind: ddbpath.FieldKindList},
-		"28": {Kind: ddbpath.FieldKindList},
-		"29": {Kind: ddbpath.FieldKindList},
-		"3":  {Kind: ddbpath.FieldKindSingle},
-		"30": {Kind: ddbpath.FieldKindList},
+			FullName: "example.message.v1.Kitchen.some_value",
+			Kind:     ddbpath.FieldKindSingle,
+			Message:  reflect.TypeOf(ddbpath.ValuePath{}),
+			Presence: true,
+		},
+		"24": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.Kitchen.opt_string",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"25": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.Kitchen.val_str",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"26": {
+			AttributeType: expression.Binary,
+			FullName:      "example.message.v1.Kitchen.val_bytes",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"27": {
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.String,
+			FullName:          "example.message.v1.Kitchen.list_of_ts",
+			Kind:              ddbpath.FieldKindList,
+		},
+		"28": {
+			AttributeType: expression.StringSet,
+			FullName:      "example.message.v1.Kitchen.string_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"29": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.Kitchen.number_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"3": {
+			AttributeType: expression.Binary,
+			FullName:      "example.message.v1.Kitchen.qr_code",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"30": {
+			AttributeType: expression.BinarySet,
+			FullName:      "example.message.v1.Kitchen.bytes_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
 		"31": {
-			Kind:    ddbpath.FieldKindList,
-			Message: reflect.TypeOf(ddbpath.AnyPath{}),
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.Kitchen.repeated_any",
+			Kind:              ddbpath.FieldKindList,
+			Message:           reflect.TypeOf(ddbpath.AnyPath{}),
 		},
 		"32": {
-			Kind:    ddbpath.FieldKindMap,
-			Message: reflect.TypeOf(ddbpath.AnyPath{}),
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.Kitchen.mapped_any",
+			Kind:              ddbpath.FieldKindMap,
+			Message:           reflect.TypeOf(ddbpath.AnyPath{}),
 		},
 		"33": {
-			Kind:    ddbpath.FieldKindList,
-			Message: reflect.TypeOf(ddbpath.FieldMaskPath{}),
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.Kitchen.repeated_fmask",
+			Kind:              ddbpath.FieldKindList,
+			Message:           reflect.TypeOf(ddbpath.FieldMaskPath{}),
 		},
 		"34": {
-			Kind:    ddbpath.FieldKindMap,
-			Message: reflect.TypeOf(ddbpath.FieldMaskPath{}),
-		},
-		"4": {Kind: ddbpath.FieldKindSingle},
-		"5": {Kind: ddbpath.FieldKindSingle},
-		"6": {Kind: ddbpath.FieldKindSingle},
-		"7": {Kind: ddbpath.FieldKindSingle},
-		"8": {Kind: ddbpath.FieldKindSingle},
-		"9": {Kind: ddbpath.FieldKindSingle},
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.Kitchen.mapped_fmask",
+			Kind:              ddbpath.FieldKindMap,
+			Message:           reflect.TypeOf(ddbpath.FieldMaskPath{}),
+		},
+		"4": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.Kitchen.num_small_knifes",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"5": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.Kitchen.num_sharp_knifes",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"6": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.Kitchen.num_blunt_knifes",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"7": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.Kitchen.num_small_forks",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"8": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.Kitchen.num_medium_forks",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"9": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.Kitchen.num_large_forks",
+			Kind:          ddbpath.FieldKindSingle,
+		},
 	})
 }
 
+// KitchenByForksKeyCond returns a key condition builder for the items in index 'by_forks' with partition key 'numSmallForks'
+func KitchenByForksKeyCond(numSmallForks int64) ddbpath.StringKeyCond {
+	return ddbpath.NewStringKeyCond("by_forks", "7", numSmallForks, "1")
+}
+
+// KitchenByKnifesKeyCond returns a key condition builder for the items in index 'by_knifes' with partition key 'brand'
+func KitchenByKnifesKeyCond(brand string) ddbpath.KeyCond[int32] {
+	return ddbpath.NewKeyCond[int32]("by_knifes", "1", brand, "4")
+}
+
 // KitchenPartitionKey returns a key builder for the partition key
 func KitchenPartitionKey() (v expression.KeyBuilder) {
 	return expression.Key("1")
@@ -408,6 +688,11 @@ func KitchenSortKeyName() (v expression.NameBuilder) {
 	return expression.Name("3")
 }
 
+// KitchenKeyCond returns a key condition builder for the items with partition key 'brand'
+func KitchenKeyCond(brand string) ddbpath.BytesKeyCond {
+	return ddbpath.NewBytesKeyCond("", "1", brand, "3")
+}
+
 // KitchenKeyNames returns the attribute names of the partition and sort keys respectively
 func KitchenKeyNames() (v []string) {
 	v = append(v, "1")
@@ -425,6 +710,26 @@ func (p EmptyPath) WithDynamoNameBuilder(n expression.NameBuilder) EmptyPath {
 	p.NameBuilder = n
 	return p
 }
+
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p EmptyPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.Empty", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p EmptyPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.Empty", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p EmptyPath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.Empty"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p EmptyPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
 func init() {
 	ddbpath.Register(EmptyPath{}, map[string]ddbpath.FieldInfo{})
 }
@@ -440,89 +745,109 @@ func (p MapGalorePath) WithDynamoNameBuilder(n expression.NameBuilder) MapGalore
 	return p
 }
 
-// Int64Int64 returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Int64Int64() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("1"))}
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p MapGalorePath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.MapGalore", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p MapGalorePath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.MapGalore", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p MapGalorePath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.MapGalore"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p MapGalorePath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
 }
 
-// Uint64Uint64 returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Uint64Uint64() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("2"))}
+// Int64Int64 returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Int64Int64() ddbpath.ItemMap[ddbpath.Number[int64]] {
+	return ddbpath.ItemMap[ddbpath.Number[int64]]{NameBuilder: p.AppendName(expression.Name("1"))}
 }
 
-// Fixed64Fixed64 returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Fixed64Fixed64() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("3"))}
+// Uint64Uint64 returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Uint64Uint64() ddbpath.ItemMap[ddbpath.Number[uint64]] {
+	return ddbpath.ItemMap[ddbpath.Number[uint64]]{NameBuilder: p.AppendName(expression.Name("2"))}
 }
 
-// Sint64Sint64 returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Sint64Sint64() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("4"))}
+// Fixed64Fixed64 returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Fixed64Fixed64() ddbpath.ItemMap[ddbpath.Number[uint64]] {
+	return ddbpath.ItemMap[ddbpath.Number[uint64]]{NameBuilder: p.AppendName(expression.Name("3"))}
 }
 
-// Sfixed64Sfixed64 returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Sfixed64Sfixed64() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("5"))}
+// Sint64Sint64 returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Sint64Sint64() ddbpath.ItemMap[ddbpath.Number[int64]] {
+	return ddbpath.ItemMap[ddbpath.Number[int64]]{NameBuilder: p.AppendName(expression.Name("4"))}
 }
 
-// Int32Int32 returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Int32Int32() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("6"))}
+// Sfixed64Sfixed64 returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Sfixed64Sfixed64() ddbpath.ItemMap[ddbpath.Number[int64]] {
+	return ddbpath.ItemMap[ddbpath.Number[int64]]{NameBuilder: p.AppendName(expression.Name("5"))}
 }
 
-// Uint32Uint32 returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Uint32Uint32() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("7"))}
+// Int32Int32 returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Int32Int32() ddbpath.ItemMap[ddbpath.Number[int32]] {
+	return ddbpath.ItemMap[ddbpath.Number[int32]]{NameBuilder: p.AppendName(expression.Name("6"))}
 }
 
-// Fixed32Fixed32 returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Fixed32Fixed32() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("8"))}
+// Uint32Uint32 returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Uint32Uint32() ddbpath.ItemMap[ddbpath.Number[uint32]] {
+	return ddbpath.ItemMap[ddbpath.Number[uint32]]{NameBuilder: p.AppendName(expression.Name("7"))}
 }
 
-// Sint32Sint32 returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Sint32Sint32() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("9"))}
+// Fixed32Fixed32 returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Fixed32Fixed32() ddbpath.ItemMap[ddbpath.Number[uint32]] {
+	return ddbpath.ItemMap[ddbpath.Number[uint32]]{NameBuilder: p.AppendName(expression.Name("8"))}
 }
 
-// Sfixed32Sfixed32 returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Sfixed32Sfixed32() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("10"))}
+// Sint32Sint32 returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Sint32Sint32() ddbpath.ItemMap[ddbpath.Number[int32]] {
+	return ddbpath.ItemMap[ddbpath.Number[int32]]{NameBuilder: p.AppendName(expression.Name("9"))}
 }
 
-// Stringstring returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Stringstring() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("11"))}
+// Sfixed32Sfixed32 returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Sfixed32Sfixed32() ddbpath.ItemMap[ddbpath.Number[int32]] {
+	return ddbpath.ItemMap[ddbpath.Number[int32]]{NameBuilder: p.AppendName(expression.Name("10"))}
 }
 
-// Boolbool returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Boolbool() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("12"))}
+// Stringstring returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Stringstring() ddbpath.ItemMap[ddbpath.String] {
+	return ddbpath.ItemMap[ddbpath.String]{NameBuilder: p.AppendName(expression.Name("11"))}
 }
 
-// Stringbytes returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Stringbytes() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("13"))}
+// Boolbool returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Boolbool() ddbpath.ItemMap[ddbpath.Bool] {
+	return ddbpath.ItemMap[ddbpath.Bool]{NameBuilder: p.AppendName(expression.Name("12"))}
 }
 
-// Stringdouble returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Stringdouble() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("14"))}
+// Stringbytes returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Stringbytes() ddbpath.ItemMap[ddbpath.Bytes] {
+	return ddbpath.ItemMap[ddbpath.Bytes]{NameBuilder: p.AppendName(expression.Name("13"))}
 }
 
-// Stringfloat returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Stringfloat() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("15"))}
+// Stringdouble returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Stringdouble() ddbpath.ItemMap[ddbpath.Number[float64]] {
+	return ddbpath.ItemMap[ddbpath.Number[float64]]{NameBuilder: p.AppendName(expression.Name("14"))}
 }
 
-// Stringduration returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Stringduration() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("16"))}
+// Stringfloat returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Stringfloat() ddbpath.ItemMap[ddbpath.Number[float32]] {
+	return ddbpath.ItemMap[ddbpath.Number[float32]]{NameBuilder: p.AppendName(expression.Name("15"))}
 }
 
-// Stringtimestamp returns 'p' appended with the attribute name and allow map keys to be specified
-func (p MapGalorePath) Stringtimestamp() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("17"))}
+// Stringduration returns 'p' appended with the attribute while allow map keys on a nested message
+func (p MapGalorePath) Stringduration() ddbpath.ItemMap[ddbpath.DurationPath] {
+	return ddbpath.ItemMap[ddbpath.DurationPath]{NameBuilder: p.AppendName(expression.Name("16"))}
+}
+
+// Stringtimestamp returns 'p' appended with the attribute while allow map keys on a nested message
+func (p MapGalorePath) Stringtimestamp() ddbpath.ItemMap[ddbpath.TimestampPath] {
+	return ddbpath.ItemMap[ddbpath.TimestampPath]{NameBuilder: p.AppendName(expression.Name("17"))}
 }
 
 // Boolengine returns 'p' appended with the attribute while allow map keys on a nested message
@@ -534,33 +859,223 @@ func (p MapGalorePath) Boolengine() ddbpath.ItemMap[EnginePath] {
 func (p MapGalorePath) Uintengine() ddbpath.ItemMap[EnginePath] {
 	return ddbpath.ItemMap[EnginePath]{NameBuilder: p.AppendName(expression.Name("19"))}
 }
+
+// Int64Enum returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Int64Enum() ddbpath.ItemMap[ddbpath.Enum[protoreflect.Enum]] {
+	return ddbpath.ItemMap[ddbpath.Enum[protoreflect.Enum]]{NameBuilder: p.AppendName(expression.Name("20"))}
+}
+
+// Stringenum returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Stringenum() ddbpath.ItemMap[ddbpath.Enum[protoreflect.Enum]] {
+	return ddbpath.ItemMap[ddbpath.Enum[protoreflect.Enum]]{NameBuilder: p.AppendName(expression.Name("21"))}
+}
+
+// Uint32Bytes returns 'p' appended with the attribute name and allow map keys of typed values
+func (p MapGalorePath) Uint32Bytes() ddbpath.ItemMap[ddbpath.Bytes] {
+	return ddbpath.ItemMap[ddbpath.Bytes]{NameBuilder: p.AppendName(expression.Name("22"))}
+}
+
+// Booltimestamp returns 'p' appended with the attribute while allow map keys on a nested message
+func (p MapGalorePath) Booltimestamp() ddbpath.ItemMap[ddbpath.TimestampPath] {
+	return ddbpath.ItemMap[ddbpath.TimestampPath]{NameBuilder: p.AppendName(expression.Name("23"))}
+}
+
+// Stringstringvalue returns 'p' appended with the attribute while allow map keys on a nested message
+func (p MapGalorePath) Stringstringvalue() ddbpath.ItemMap[ddbpath.StringValuePath] {
+	return ddbpath.ItemMap[ddbpath.StringValuePath]{NameBuilder: p.AppendName(expression.Name("24"))}
+}
+
+// Stringint64Value returns 'p' appended with the attribute while allow map keys on a nested message
+func (p MapGalorePath) Stringint64Value() ddbpath.ItemMap[ddbpath.Int64ValuePath] {
+	return ddbpath.ItemMap[ddbpath.Int64ValuePath]{NameBuilder: p.AppendName(expression.Name("25"))}
+}
+
+// Stringboolvalue returns 'p' appended with the attribute while allow map keys on a nested message
+func (p MapGalorePath) Stringboolvalue() ddbpath.ItemMap[ddbpath.BoolValuePath] {
+	return ddbpath.ItemMap[ddbpath.BoolValuePath]{NameBuilder: p.AppendName(expression.Name("26"))}
+}
+
+// Stringbytesvalue returns 'p' appended with the attribute while allow map keys on a nested message
+func (p MapGalorePath) Stringbytesvalue() ddbpath.ItemMap[ddbpath.BytesValuePath] {
+	return ddbpath.ItemMap[ddbpath.BytesValuePath]{NameBuilder: p.AppendName(expression.Name("27"))}
+}
+
+// Stringdoublevalue returns 'p' appended with the attribute while allow map keys on a nested message
+func (p MapGalorePath) Stringdoublevalue() ddbpath.ItemMap[ddbpath.DoubleValuePath] {
+	return ddbpath.ItemMap[ddbpath.DoubleValuePath]{NameBuilder: p.AppendName(expression.Name("28"))}
+}
 func init() {
 	ddbpath.Register(MapGalorePath{}, map[string]ddbpath.FieldInfo{
-		"1":  {Kind: ddbpath.FieldKindMap},
-		"10": {Kind: ddbpath.FieldKindMap},
-		"11": {Kind: ddbpath.FieldKindMap},
-		"12": {Kind: ddbpath.FieldKindMap},
-		"13": {Kind: ddbpath.FieldKindMap},
-		"14": {Kind: ddbpath.FieldKindMap},
-		"15": {Kind: ddbpath.FieldKindMap},
-		"16": {Kind: ddbpath.FieldKindMap},
-		"17": {Kind: ddbpath.FieldKindMap},
+		"1": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.int64int64",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"10": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.sfixed32sfixed32",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"11": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.String,
+			FullName:          "example.message.v1.MapGalore.stringstring",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"12": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Boolean,
+			FullName:          "example.message.v1.MapGalore.boolbool",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"13": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Binary,
+			FullName:          "example.message.v1.MapGalore.stringbytes",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"14": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.stringdouble",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"15": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.stringfloat",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"16": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.String,
+			FullName:          "example.message.v1.MapGalore.stringduration",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"17": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.String,
+			FullName:          "example.message.v1.MapGalore.stringtimestamp",
+			Kind:              ddbpath.FieldKindMap,
+		},
 		"18": {
-			Kind:    ddbpath.FieldKindMap,
-			Message: reflect.TypeOf(EnginePath{}),
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.MapGalore.boolengine",
+			Kind:              ddbpath.FieldKindMap,
+			Message:           reflect.TypeOf(EnginePath{}),
 		},
 		"19": {
-			Kind:    ddbpath.FieldKindMap,
-			Message: reflect.TypeOf(EnginePath{}),
-		},
-		"2": {Kind: ddbpath.FieldKindMap},
-		"3": {Kind: ddbpath.FieldKindMap},
-		"4": {Kind: ddbpath.FieldKindMap},
-		"5": {Kind: ddbpath.FieldKindMap},
-		"6": {Kind: ddbpath.FieldKindMap},
-		"7": {Kind: ddbpath.FieldKindMap},
-		"8": {Kind: ddbpath.FieldKindMap},
-		"9": {Kind: ddbpath.FieldKindMap},
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.MapGalore.uintengine",
+			Kind:              ddbpath.FieldKindMap,
+			Message:           reflect.TypeOf(EnginePath{}),
+		},
+		"2": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.uint64uint64",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"20": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.int64enum",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"21": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.stringenum",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"22": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Binary,
+			FullName:          "example.message.v1.MapGalore.uint32bytes",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"23": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.String,
+			FullName:          "example.message.v1.MapGalore.booltimestamp",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"24": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.String,
+			FullName:          "example.message.v1.MapGalore.stringstringvalue",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"25": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.stringint64value",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"26": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Boolean,
+			FullName:          "example.message.v1.MapGalore.stringboolvalue",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"27": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Binary,
+			FullName:          "example.message.v1.MapGalore.stringbytesvalue",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"28": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.stringdoublevalue",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"3": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.fixed64fixed64",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"4": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.sint64sint64",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"5": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.sfixed64sfixed64",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"6": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.int32int32",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"7": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.uint32uint32",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"8": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.fixed32fixed32",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"9": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Number,
+			FullName:          "example.message.v1.MapGalore.sint32sint32",
+			Kind:              ddbpath.FieldKindMap,
+		},
 	})
 }
 
@@ -575,15 +1090,87 @@ func (p ValueGalorePath) WithDynamoNameBuilder(n expression.NameBuilder) ValueGa
 	return p
 }
 
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p ValueGalorePath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.ValueGalore", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p ValueGalorePath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.ValueGalore", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p ValueGalorePath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.ValueGalore"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p ValueGalorePath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
 // SomeValue returns 'p' with the attribute name appended and allow subselecting nested message
 func (p ValueGalorePath) SomeValue() ddbpath.ValuePath {
 	return ddbpath.ValuePath{NameBuilder: p.AppendName(expression.Name("1"))}
 }
+
+// SomeStruct returns 'p' with the attribute name appended and allow subselecting nested message
+func (p ValueGalorePath) SomeStruct() ddbpath.StructPath {
+	return ddbpath.StructPath{NameBuilder: p.AppendName(expression.Name("2"))}
+}
+
+// SomeList returns 'p' with the attribute name appended and allow subselecting nested message
+func (p ValueGalorePath) SomeList() ddbpath.ListValuePath {
+	return ddbpath.ListValuePath{NameBuilder: p.AppendName(expression.Name("3"))}
+}
+
+// Structs returns 'p' appended with the attribute while allow indexing a nested message
+func (p ValueGalorePath) Structs() ddbpath.ItemList[ddbpath.StructPath] {
+	return ddbpath.ItemList[ddbpath.StructPath]{NameBuilder: p.AppendName(expression.Name("4"))}
+}
+
+// Lists returns 'p' appended with the attribute while allow map keys on a nested message
+func (p ValueGalorePath) Lists() ddbpath.ItemMap[ddbpath.ListValuePath] {
+	return ddbpath.ItemMap[ddbpath.ListValuePath]{NameBuilder: p.AppendName(expression.Name("5"))}
+}
 func init() {
-	ddbpath.Register(ValueGalorePath{}, map[string]ddbpath.FieldInfo{"1": {
-		Kind:    ddbpath.FieldKindSingle,
-		Message: reflect.TypeOf(ddbpath.ValuePath{}),
-	}})
+	ddbpath.Register(ValueGalorePath{}, map[string]ddbpath.FieldInfo{
+		"1": {
+			FullName: "example.message.v1.ValueGalore.some_value",
+			Kind:     ddbpath.FieldKindSingle,
+			Message:  reflect.TypeOf(ddbpath.ValuePath{}),
+			Presence: true,
+		},
+		"2": {
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.ValueGalore.some_struct",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(ddbpath.StructPath{}),
+			Presence:      true,
+		},
+		"3": {
+			AttributeType: expression.List,
+			FullName:      "example.message.v1.ValueGalore.some_list",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(ddbpath.ListValuePath{}),
+			Presence:      true,
+		},
+		"4": {
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.ValueGalore.structs",
+			Kind:              ddbpath.FieldKindList,
+			Message:           reflect.TypeOf(ddbpath.StructPath{}),
+		},
+		"5": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.List,
+			FullName:          "example.message.v1.ValueGalore.lists",
+			Kind:              ddbpath.FieldKindMap,
+			Message:           reflect.TypeOf(ddbpath.ListValuePath{}),
+		},
+	})
 }
 
 // FieldPresencePath allows for constructing type-safe expression names
@@ -597,14 +1184,34 @@ func (p FieldPresencePath) WithDynamoNameBuilder(n expression.NameBuilder) Field
 	return p
 }
 
-// Str appends the path being build
-func (p FieldPresencePath) Str() expression.NameBuilder {
-	return p.AppendName(expression.Name("str"))
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p FieldPresencePath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.FieldPresence", x)
 }
 
-// OptStr appends the path being build
-func (p FieldPresencePath) OptStr() expression.NameBuilder {
-	return p.AppendName(expression.Name("optStr"))
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p FieldPresencePath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.FieldPresence", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p FieldPresencePath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.FieldPresence"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p FieldPresencePath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// Str returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) Str() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("str")))
+}
+
+// OptStr returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) OptStr() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("optStr")))
 }
 
 // Msg returns 'p' with the attribute name appended and allow subselecting nested message
@@ -617,9 +1224,9 @@ func (p FieldPresencePath) OptMsg() EnginePath {
 	return EnginePath{NameBuilder: p.AppendName(expression.Name("optMsg"))}
 }
 
-// StrList returns 'p' appended with the attribute name and allow indexing
-func (p FieldPresencePath) StrList() ddbpath.List {
-	return ddbpath.List{NameBuilder: p.AppendName(expression.Name("strList"))}
+// StrList returns 'p' appended with the attribute name and allow indexing typed values
+func (p FieldPresencePath) StrList() ddbpath.StringList {
+	return ddbpath.StringList{}.WithDynamoNameBuilder(p.AppendName(expression.Name("strList")))
 }
 
 // MsgList returns 'p' appended with the attribute while allow indexing a nested message
@@ -627,9 +1234,9 @@ func (p FieldPresencePath) MsgList() ddbpath.ItemList[EnginePath] {
 	return ddbpath.ItemList[EnginePath]{NameBuilder: p.AppendName(expression.Name("msgList"))}
 }
 
-// StrMap returns 'p' appended with the attribute name and allow map keys to be specified
-func (p FieldPresencePath) StrMap() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("strMap"))}
+// StrMap returns 'p' appended with the attribute name and allow map keys of typed values
+func (p FieldPresencePath) StrMap() ddbpath.ItemMap[ddbpath.String] {
+	return ddbpath.ItemMap[ddbpath.String]{NameBuilder: p.AppendName(expression.Name("strMap"))}
 }
 
 // MsgMap returns 'p' appended with the attribute while allow map keys on a nested message
@@ -637,108 +1244,271 @@ func (p FieldPresencePath) MsgMap() ddbpath.ItemMap[EnginePath] {
 	return ddbpath.ItemMap[EnginePath]{NameBuilder: p.AppendName(expression.Name("msgMap"))}
 }
 
-// Enum appends the path being build
-func (p FieldPresencePath) Enum() expression.NameBuilder {
-	return p.AppendName(expression.Name("enum"))
+// Enum returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) Enum() ddbpath.Enum[protoreflect.Enum] {
+	return ddbpath.Enum[protoreflect.Enum]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("enum")))
+}
+
+// OptEnum returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) OptEnum() ddbpath.Enum[protoreflect.Enum] {
+	return ddbpath.Enum[protoreflect.Enum]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("optEnum")))
+}
+
+// StrVal returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) StrVal() ddbpath.StringValuePath {
+	return ddbpath.StringValuePath{NameBuilder: p.AppendName(expression.Name("strVal"))}
+}
+
+// BoolVal returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) BoolVal() ddbpath.BoolValuePath {
+	return ddbpath.BoolValuePath{NameBuilder: p.AppendName(expression.Name("boolVal"))}
+}
+
+// BytesVal returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) BytesVal() ddbpath.BytesValuePath {
+	return ddbpath.BytesValuePath{NameBuilder: p.AppendName(expression.Name("bytesVal"))}
+}
+
+// DoubleVal returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) DoubleVal() ddbpath.DoubleValuePath {
+	return ddbpath.DoubleValuePath{NameBuilder: p.AppendName(expression.Name("doubleVal"))}
+}
+
+// FloatVal returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) FloatVal() ddbpath.FloatValuePath {
+	return ddbpath.FloatValuePath{NameBuilder: p.AppendName(expression.Name("floatVal"))}
+}
+
+// Int32Val returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) Int32Val() ddbpath.Int32ValuePath {
+	return ddbpath.Int32ValuePath{NameBuilder: p.AppendName(expression.Name("int32Val"))}
+}
+
+// Int64Val returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) Int64Val() ddbpath.Int64ValuePath {
+	return ddbpath.Int64ValuePath{NameBuilder: p.AppendName(expression.Name("int64Val"))}
+}
+
+// Uint32Val returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) Uint32Val() ddbpath.UInt32ValuePath {
+	return ddbpath.UInt32ValuePath{NameBuilder: p.AppendName(expression.Name("uint32Val"))}
+}
+
+// Uint64Val returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p FieldPresencePath) Uint64Val() ddbpath.UInt64ValuePath {
+	return ddbpath.UInt64ValuePath{NameBuilder: p.AppendName(expression.Name("uint64Val"))}
+}
+
+// FieldPresenceOoOneofPath groups the paths of the members of oneof 'oo'
+type FieldPresenceOoOneofPath struct {
+	expression.NameBuilder
+}
+
+// Oo returns the path group of the oneof members that sets one member at a time
+func (p FieldPresencePath) Oo() FieldPresenceOoOneofPath {
+	return FieldPresenceOoOneofPath{NameBuilder: p.NameBuilder}
 }
 
-// OptEnum appends the path being build
-func (p FieldPresencePath) OptEnum() expression.NameBuilder {
-	return p.AppendName(expression.Name("optEnum"))
+// SetOneofStr returns an update that sets member 'oneof_str' to 'v' and removes the other members
+func (p FieldPresenceOoOneofPath) SetOneofStr(v string) ddbpath.Update {
+	return ddbpath.SetOneof(ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("oneofStr"))).Set(v), p.AppendName(expression.Name("oneofMsg")))
 }
 
-// OneofStr appends the path being build
-func (p FieldPresencePath) OneofStr() expression.NameBuilder {
-	return p.AppendName(expression.Name("oneofStr"))
+// WhichOneofStr returns a condition that checks if 'oneof_str' is the member of the oneof that is set
+func (p FieldPresenceOoOneofPath) WhichOneofStr() expression.ConditionBuilder {
+	return ddbpath.WhichOneof(p.AppendName(expression.Name("oneofStr")), p.AppendName(expression.Name("oneofMsg")))
 }
 
-// OneofMsg returns 'p' with the attribute name appended and allow subselecting nested message
-func (p FieldPresencePath) OneofMsg() EnginePath {
-	return EnginePath{NameBuilder: p.AppendName(expression.Name("oneofMsg"))}
+// FieldPresenceOneofStrMemberPath is the path to member 'oneof_str' of oneof 'oo', its updates remove the other members
+type FieldPresenceOneofStrMemberPath struct {
+	ddbpath.String
+	oneof FieldPresenceOoOneofPath
 }
 
-// StrVal appends the path being build
-func (p FieldPresencePath) StrVal() expression.NameBuilder {
-	return p.AppendName(expression.Name("strVal"))
+// OneofStr returns 'p' with the attribute name appended and allow updating it as a member of oneof 'oo'
+func (p FieldPresencePath) OneofStr() FieldPresenceOneofStrMemberPath {
+	return FieldPresenceOneofStrMemberPath{
+		String: ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("oneofStr"))),
+		oneof:  p.Oo(),
+	}
 }
 
-// BoolVal appends the path being build
-func (p FieldPresencePath) BoolVal() expression.NameBuilder {
-	return p.AppendName(expression.Name("boolVal"))
+// Set returns the update of the member's path, that also removes the other members
+func (p FieldPresenceOneofStrMemberPath) Set(v string) ddbpath.Update {
+	return ddbpath.SetOneof(p.String.Set(v), p.oneof.AppendName(expression.Name("oneofMsg")))
 }
 
-// BytesVal appends the path being build
-func (p FieldPresencePath) BytesVal() expression.NameBuilder {
-	return p.AppendName(expression.Name("bytesVal"))
+// SetIfNotExists returns the update of the member's path, that also removes the other members
+func (p FieldPresenceOneofStrMemberPath) SetIfNotExists(v string) ddbpath.Update {
+	return ddbpath.SetOneof(p.String.SetIfNotExists(v), p.oneof.AppendName(expression.Name("oneofMsg")))
 }
 
-// DoubleVal appends the path being build
-func (p FieldPresencePath) DoubleVal() expression.NameBuilder {
-	return p.AppendName(expression.Name("doubleVal"))
+// SetOneofMsg returns an update that sets member 'oneof_msg' to 'v' and removes the other members
+func (p FieldPresenceOoOneofPath) SetOneofMsg(v proto.Message) ddbpath.Update {
+	return ddbpath.SetOneof(ddbpath.SetMessage(p.AppendName(expression.Name("oneofMsg")), "example.message.v1.Engine", v), p.AppendName(expression.Name("oneofStr")))
 }
 
-// FloatVal appends the path being build
-func (p FieldPresencePath) FloatVal() expression.NameBuilder {
-	return p.AppendName(expression.Name("floatVal"))
+// WhichOneofMsg returns a condition that checks if 'oneof_msg' is the member of the oneof that is set
+func (p FieldPresenceOoOneofPath) WhichOneofMsg() expression.ConditionBuilder {
+	return ddbpath.WhichOneof(p.AppendName(expression.Name("oneofMsg")), p.AppendName(expression.Name("oneofStr")))
 }
 
-// Int32Val appends the path being build
-func (p FieldPresencePath) Int32Val() expression.NameBuilder {
-	return p.AppendName(expression.Name("int32Val"))
+// FieldPresenceOneofMsgMemberPath is the path to member 'oneof_msg' of oneof 'oo', its updates remove the other members
+// Nested fields can't be selected since their updates wouldn't remove the other members, the
+// member is updated as a whole, or through the oneof's Set method.
+type FieldPresenceOneofMsgMemberPath struct {
+	expression.NameBuilder
+	oneof FieldPresenceOoOneofPath
 }
 
-// Int64Val appends the path being build
-func (p FieldPresencePath) Int64Val() expression.NameBuilder {
-	return p.AppendName(expression.Name("int64Val"))
+// OneofMsg returns 'p' with the attribute name appended and allow updating it as a member of oneof 'oo'
+func (p FieldPresencePath) OneofMsg() FieldPresenceOneofMsgMemberPath {
+	return FieldPresenceOneofMsgMemberPath{
+		NameBuilder: p.AppendName(expression.Name("oneofMsg")),
+		oneof:       p.Oo(),
+	}
 }
 
-// Uint32Val appends the path being build
-func (p FieldPresencePath) Uint32Val() expression.NameBuilder {
-	return p.AppendName(expression.Name("uint32Val"))
+// DynamoSet returns the update of the member's path, that also removes the other members
+func (p FieldPresenceOneofMsgMemberPath) DynamoSet(v proto.Message) ddbpath.Update {
+	return ddbpath.SetOneof(ddbpath.SetMessage(p.NameBuilder, "example.message.v1.Engine", v), p.oneof.AppendName(expression.Name("oneofStr")))
 }
 
-// Uint64Val appends the path being build
-func (p FieldPresencePath) Uint64Val() expression.NameBuilder {
-	return p.AppendName(expression.Name("uint64Val"))
+// DynamoSetIfNotExists returns the update of the member's path, that also removes the other members
+func (p FieldPresenceOneofMsgMemberPath) DynamoSetIfNotExists(v proto.Message) ddbpath.Update {
+	return ddbpath.SetOneof(ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.Engine", v), p.oneof.AppendName(expression.Name("oneofStr")))
 }
 func init() {
 	ddbpath.Register(FieldPresencePath{}, map[string]ddbpath.FieldInfo{
-		"boolVal":   {Kind: ddbpath.FieldKindSingle},
-		"bytesVal":  {Kind: ddbpath.FieldKindSingle},
-		"doubleVal": {Kind: ddbpath.FieldKindSingle},
-		"enum":      {Kind: ddbpath.FieldKindSingle},
-		"floatVal":  {Kind: ddbpath.FieldKindSingle},
-		"int32Val":  {Kind: ddbpath.FieldKindSingle},
-		"int64Val":  {Kind: ddbpath.FieldKindSingle},
+		"boolVal": {
+			AttributeType: expression.Boolean,
+			FullName:      "example.message.v1.FieldPresence.bool_val",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"bytesVal": {
+			AttributeType: expression.Binary,
+			FullName:      "example.message.v1.FieldPresence.bytes_val",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"doubleVal": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.FieldPresence.double_val",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"enum": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.FieldPresence.enum",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"floatVal": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.FieldPresence.float_val",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"int32Val": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.FieldPresence.int32_val",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"int64Val": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.FieldPresence.int64_val",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
 		"msg": {
-			Kind:    ddbpath.FieldKindSingle,
-			Message: reflect.TypeOf(EnginePath{}),
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.FieldPresence.msg",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(EnginePath{}),
+			Presence:      true,
 		},
 		"msgList": {
-			Kind:    ddbpath.FieldKindList,
-			Message: reflect.TypeOf(EnginePath{}),
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.FieldPresence.msg_list",
+			Kind:              ddbpath.FieldKindList,
+			Message:           reflect.TypeOf(EnginePath{}),
 		},
 		"msgMap": {
-			Kind:    ddbpath.FieldKindMap,
-			Message: reflect.TypeOf(EnginePath{}),
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.FieldPresence.msg_map",
+			Kind:              ddbpath.FieldKindMap,
+			Message:           reflect.TypeOf(EnginePath{}),
 		},
 		"oneofMsg": {
-			Kind:    ddbpath.FieldKindSingle,
-			Message: reflect.TypeOf(EnginePath{}),
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.FieldPresence.oneof_msg",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(EnginePath{}),
+			Presence:      true,
+		},
+		"oneofStr": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.FieldPresence.oneof_str",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"optEnum": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.FieldPresence.opt_enum",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
 		},
-		"oneofStr": {Kind: ddbpath.FieldKindSingle},
-		"optEnum":  {Kind: ddbpath.FieldKindSingle},
 		"optMsg": {
-			Kind:    ddbpath.FieldKindSingle,
-			Message: reflect.TypeOf(EnginePath{}),
-		},
-		"optStr":    {Kind: ddbpath.FieldKindSingle},
-		"str":       {Kind: ddbpath.FieldKindSingle},
-		"strList":   {Kind: ddbpath.FieldKindList},
-		"strMap":    {Kind: ddbpath.FieldKindMap},
-		"strVal":    {Kind: ddbpath.FieldKindSingle},
-		"uint32Val": {Kind: ddbpath.FieldKindSingle},
-		"uint64Val": {Kind: ddbpath.FieldKindSingle},
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.FieldPresence.opt_msg",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(EnginePath{}),
+			Presence:      true,
+		},
+		"optStr": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.FieldPresence.opt_str",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"str": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.FieldPresence.str",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"strList": {
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.String,
+			FullName:          "example.message.v1.FieldPresence.str_list",
+			Kind:              ddbpath.FieldKindList,
+		},
+		"strMap": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.String,
+			FullName:          "example.message.v1.FieldPresence.str_map",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"strVal": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.FieldPresence.str_val",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"uint32Val": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.FieldPresence.uint32_val",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"uint64Val": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.FieldPresence.uint64_val",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
 	})
 }
 
@@ -753,46 +1523,95 @@ func (p JsonFieldsPath) WithDynamoNameBuilder(n expression.NameBuilder) JsonFiel
 	return p
 }
 
-// JsonStrList returns 'p' appended with the attribute name and allow indexing
-func (p JsonFieldsPath) JsonStrList() ddbpath.List {
-	return ddbpath.List{NameBuilder: p.AppendName(expression.Name("1"))}
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p JsonFieldsPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.JsonFields", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p JsonFieldsPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.JsonFields", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p JsonFieldsPath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.JsonFields"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p JsonFieldsPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// JsonStrList returns 'p' with the attribute name appended and allow updating the embedded value
+func (p JsonFieldsPath) JsonStrList() ddbpath.Embedded {
+	return ddbpath.NewEmbedded(p.AppendName(expression.Name("1")), v1.Encoding_ENCODING_JSON)
 }
 
-// JsonEngine appends the path being build
-func (p JsonFieldsPath) JsonEngine() expression.NameBuilder {
-	return p.AppendName(expression.Name("json_engine"))
+// JsonEngine returns 'p' with the attribute name appended and allow updating the embedded value
+func (p JsonFieldsPath) JsonEngine() ddbpath.Embedded {
+	return ddbpath.NewEmbeddedMessage(p.AppendName(expression.Name("json_engine")), v1.Encoding_ENCODING_JSON, "example.message.v1.Engine")
 }
 
-// JsonIntMap returns 'p' appended with the attribute name and allow map keys to be specified
-func (p JsonFieldsPath) JsonIntMap() ddbpath.Map {
-	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("4"))}
+// JsonIntMap returns 'p' with the attribute name appended and allow updating the embedded value
+func (p JsonFieldsPath) JsonIntMap() ddbpath.Embedded {
+	return ddbpath.NewEmbedded(p.AppendName(expression.Name("4")), v1.Encoding_ENCODING_JSON)
 }
 
-// JsonEngineList returns 'p' appended with the attribute name and allow indexing
-func (p JsonFieldsPath) JsonEngineList() ddbpath.List {
-	return ddbpath.List{NameBuilder: p.AppendName(expression.Name("2"))}
+// JsonEngineList returns 'p' with the attribute name appended and allow updating the embedded value
+func (p JsonFieldsPath) JsonEngineList() ddbpath.Embedded {
+	return ddbpath.NewEmbedded(p.AppendName(expression.Name("2")), v1.Encoding_ENCODING_JSON)
 }
 
-// JsonEngineMap returns 'p' appended with the attribute while allow map keys on a nested message
-func (p JsonFieldsPath) JsonEngineMap() ddbpath.ItemMap[EnginePath] {
-	return ddbpath.ItemMap[EnginePath]{NameBuilder: p.AppendName(expression.Name("5"))}
+// JsonEngineMap returns 'p' with the attribute name appended and allow updating the embedded value
+func (p JsonFieldsPath) JsonEngineMap() ddbpath.Embedded {
+	return ddbpath.NewEmbedded(p.AppendName(expression.Name("5")), v1.Encoding_ENCODING_JSON)
 }
 
-// JsonNrSet returns 'p' appended with the attribute name and allow indexing
-func (p JsonFieldsPath) JsonNrSet() ddbpath.List {
-	return ddbpath.List{NameBuilder: p.AppendName(expression.Name("6"))}
+// JsonNrSet returns 'p' with the attribute name appended and allow updating the embedded value
+func (p JsonFieldsPath) JsonNrSet() ddbpath.Embedded {
+	return ddbpath.NewEmbedded(p.AppendName(expression.Name("6")), v1.Encoding_ENCODING_JSON)
 }
 func init() {
 	ddbpath.Register(JsonFieldsPath{}, map[string]ddbpath.FieldInfo{
-		"1": {Kind: ddbpath.FieldKindList},
-		"2": {Kind: ddbpath.FieldKindList},
-		"4": {Kind: ddbpath.FieldKindMap},
+		"1": {
+			AttributeType: expression.String,
+			Embed:         v1.Encoding_ENCODING_JSON,
+			FullName:      "example.message.v1.JsonFields.json_str_list",
+			Kind:          ddbpath.FieldKindList,
+		},
+		"2": {
+			AttributeType: expression.String,
+			Embed:         v1.Encoding_ENCODING_JSON,
+			FullName:      "example.message.v1.JsonFields.json_engine_list",
+			Kind:          ddbpath.FieldKindList,
+		},
+		"4": {
+			AttributeType: expression.String,
+			Embed:         v1.Encoding_ENCODING_JSON,
+			FullName:      "example.message.v1.JsonFields.json_int_map",
+			Kind:          ddbpath.FieldKindMap,
+		},
 		"5": {
-			Kind:    ddbpath.FieldKindMap,
-			Message: reflect.TypeOf(EnginePath{}),
+			AttributeType: expression.String,
+			Embed:         v1.Encoding_ENCODING_JSON,
+			FullName:      "example.message.v1.JsonFields.json_engine_map",
+			Kind:          ddbpath.FieldKindMap,
+		},
+		"6": {
+			AttributeType: expression.String,
+			Embed:         v1.Encoding_ENCODING_JSON,
+			FullName:      "example.message.v1.JsonFields.json_nr_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"json_engine": {
+			AttributeType: expression.String,
+			Embed:         v1.Encoding_ENCODING_JSON,
+			FullName:      "example.message.v1.JsonFields.json_engine",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
 		},
-		"6":           {Kind: ddbpath.FieldKindList},
-		"json_engine": {Kind: ddbpath.FieldKindSingle},
 	})
 }
 
@@ -807,18 +1626,631 @@ func (p JsonOneofsPath) WithDynamoNameBuilder(n expression.NameBuilder) JsonOneo
 	return p
 }
 
-// OneofStr appends the path being build
-func (p JsonOneofsPath) OneofStr() expression.NameBuilder {
-	return p.AppendName(expression.Name("7"))
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p JsonOneofsPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.JsonOneofs", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p JsonOneofsPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.JsonOneofs", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p JsonOneofsPath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.JsonOneofs"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p JsonOneofsPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// JsonOneofsJsonOoOneofPath groups the paths of the members of oneof 'json_oo'
+type JsonOneofsJsonOoOneofPath struct {
+	expression.NameBuilder
+}
+
+// JsonOo returns the path group of the oneof members that sets one member at a time
+func (p JsonOneofsPath) JsonOo() JsonOneofsJsonOoOneofPath {
+	return JsonOneofsJsonOoOneofPath{NameBuilder: p.NameBuilder}
+}
+
+// SetOneofStr returns an update that sets member 'oneof_str' to 'v' and removes the other members
+func (p JsonOneofsJsonOoOneofPath) SetOneofStr(v string) ddbpath.Update {
+	return ddbpath.SetOneof(ddbpath.NewEmbedded(p.AppendName(expression.Name("7")), v1.Encoding_ENCODING_JSON).Set(v), p.AppendName(expression.Name("8")))
+}
+
+// WhichOneofStr returns a condition that checks if 'oneof_str' is the member of the oneof that is set
+func (p JsonOneofsJsonOoOneofPath) WhichOneofStr() expression.ConditionBuilder {
+	return ddbpath.WhichOneof(p.AppendName(expression.Name("7")), p.AppendName(expression.Name("8")))
+}
+
+// JsonOneofsOneofStrMemberPath is the path to member 'oneof_str' of oneof 'json_oo', its updates remove the other members
+type JsonOneofsOneofStrMemberPath struct {
+	ddbpath.Embedded
+	oneof JsonOneofsJsonOoOneofPath
+}
+
+// OneofStr returns 'p' with the attribute name appended and allow updating it as a member of oneof 'json_oo'
+func (p JsonOneofsPath) OneofStr() JsonOneofsOneofStrMemberPath {
+	return JsonOneofsOneofStrMemberPath{
+		Embedded: ddbpath.NewEmbedded(p.AppendName(expression.Name("7")), v1.Encoding_ENCODING_JSON),
+		oneof:    p.JsonOo(),
+	}
+}
+
+// Set returns the update of the member's path, that also removes the other members
+func (p JsonOneofsOneofStrMemberPath) Set(v string) ddbpath.Update {
+	return ddbpath.SetOneof(p.Embedded.Set(v), p.oneof.AppendName(expression.Name("8")))
+}
+
+// SetIfNotExists returns the update of the member's path, that also removes the other members
+func (p JsonOneofsOneofStrMemberPath) SetIfNotExists(v string) ddbpath.Update {
+	return ddbpath.SetOneof(p.Embedded.SetIfNotExists(v), p.oneof.AppendName(expression.Name("8")))
 }
 
-// OneofMsg appends the path being build
-func (p JsonOneofsPath) OneofMsg() expression.NameBuilder {
-	return p.AppendName(expression.Name("8"))
+// SetOneofMsg returns an update that sets member 'oneof_msg' to 'v' and removes the other members
+func (p JsonOneofsJsonOoOneofPath) SetOneofMsg(v proto.Message) ddbpath.Update {
+	return ddbpath.SetOneof(ddbpath.NewEmbeddedMessage(p.AppendName(expression.Name("8")), v1.Encoding_ENCODING_JSON, "example.message.v1.Engine").Set(v), p.AppendName(expression.Name("7")))
+}
+
+// WhichOneofMsg returns a condition that checks if 'oneof_msg' is the member of the oneof that is set
+func (p JsonOneofsJsonOoOneofPath) WhichOneofMsg() expression.ConditionBuilder {
+	return ddbpath.WhichOneof(p.AppendName(expression.Name("8")), p.AppendName(expression.Name("7")))
+}
+
+// JsonOneofsOneofMsgMemberPath is the path to member 'oneof_msg' of oneof 'json_oo', its updates remove the other members
+type JsonOneofsOneofMsgMemberPath struct {
+	ddbpath.Embedded
+	oneof JsonOneofsJsonOoOneofPath
+}
+
+// OneofMsg returns 'p' with the attribute name appended and allow updating it as a member of oneof 'json_oo'
+func (p JsonOneofsPath) OneofMsg() JsonOneofsOneofMsgMemberPath {
+	return JsonOneofsOneofMsgMemberPath{
+		Embedded: ddbpath.NewEmbeddedMessage(p.AppendName(expression.Name("8")), v1.Encoding_ENCODING_JSON, "example.message.v1.Engine"),
+		oneof:    p.JsonOo(),
+	}
+}
+
+// Set returns the update of the member's path, that also removes the other members
+func (p JsonOneofsOneofMsgMemberPath) Set(v proto.Message) ddbpath.Update {
+	return ddbpath.SetOneof(p.Embedded.Set(v), p.oneof.AppendName(expression.Name("7")))
+}
+
+// SetIfNotExists returns the update of the member's path, that also removes the other members
+func (p JsonOneofsOneofMsgMemberPath) SetIfNotExists(v proto.Message) ddbpath.Update {
+	return ddbpath.SetOneof(p.Embedded.SetIfNotExists(v), p.oneof.AppendName(expression.Name("7")))
 }
 func init() {
 	ddbpath.Register(JsonOneofsPath{}, map[string]ddbpath.FieldInfo{
-		"7": {Kind: ddbpath.FieldKindSingle},
-		"8": {Kind: ddbpath.FieldKindSingle},
+		"7": {
+			AttributeType: expression.String,
+			Embed:         v1.Encoding_ENCODING_JSON,
+			FullName:      "example.message.v1.JsonOneofs.oneof_str",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"8": {
+			AttributeType: expression.String,
+			Embed:         v1.Encoding_ENCODING_JSON,
+			FullName:      "example.message.v1.JsonOneofs.oneof_msg",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+	})
+}
+
+// SetGalorePath allows for constructing type-safe expression names
+type SetGalorePath struct {
+	expression.NameBuilder
+}
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p SetGalorePath) WithDynamoNameBuilder(n expression.NameBuilder) SetGalorePath {
+	p.NameBuilder = n
+	return p
+}
+
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p SetGalorePath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.SetGalore", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p SetGalorePath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.SetGalore", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p SetGalorePath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.SetGalore"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p SetGalorePath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// StringSet returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) StringSet() ddbpath.StringSet {
+	return ddbpath.StringSet{}.WithDynamoNameBuilder(p.AppendName(expression.Name("1")))
+}
+
+// BytesSet returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) BytesSet() ddbpath.ValueSet[[]byte] {
+	return ddbpath.ValueSet[[]byte]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("2")))
+}
+
+// Int32Set returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) Int32Set() ddbpath.ValueSet[int32] {
+	return ddbpath.ValueSet[int32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("3")))
+}
+
+// Int64Set returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) Int64Set() ddbpath.ValueSet[int64] {
+	return ddbpath.ValueSet[int64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("4")))
+}
+
+// Uint32Set returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) Uint32Set() ddbpath.ValueSet[uint32] {
+	return ddbpath.ValueSet[uint32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("5")))
+}
+
+// Uint64Set returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) Uint64Set() ddbpath.ValueSet[uint64] {
+	return ddbpath.ValueSet[uint64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("6")))
+}
+
+// Sint32Set returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) Sint32Set() ddbpath.ValueSet[int32] {
+	return ddbpath.ValueSet[int32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("7")))
+}
+
+// Sint64Set returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) Sint64Set() ddbpath.ValueSet[int64] {
+	return ddbpath.ValueSet[int64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("8")))
+}
+
+// Fixed32Set returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) Fixed32Set() ddbpath.ValueSet[uint32] {
+	return ddbpath.ValueSet[uint32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("9")))
+}
+
+// Fixed64Set returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) Fixed64Set() ddbpath.ValueSet[uint64] {
+	return ddbpath.ValueSet[uint64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("10")))
+}
+
+// Sfixed32Set returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) Sfixed32Set() ddbpath.ValueSet[int32] {
+	return ddbpath.ValueSet[int32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("11")))
+}
+
+// Sfixed64Set returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) Sfixed64Set() ddbpath.ValueSet[int64] {
+	return ddbpath.ValueSet[int64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("12")))
+}
+
+// FloatSet returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) FloatSet() ddbpath.ValueSet[float32] {
+	return ddbpath.ValueSet[float32]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("13")))
+}
+
+// DoubleSet returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) DoubleSet() ddbpath.ValueSet[float64] {
+	return ddbpath.ValueSet[float64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("14")))
+}
+
+// EnumSet returns 'p' appended with the attribute name and allow updating the set
+func (p SetGalorePath) EnumSet() ddbpath.EnumSet[protoreflect.Enum] {
+	return ddbpath.EnumSet[protoreflect.Enum]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("15")))
+}
+func init() {
+	ddbpath.Register(SetGalorePath{}, map[string]ddbpath.FieldInfo{
+		"1": {
+			AttributeType: expression.StringSet,
+			FullName:      "example.message.v1.SetGalore.string_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"10": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.fixed64_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"11": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.sfixed32_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"12": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.sfixed64_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"13": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.float_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"14": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.double_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"15": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.enum_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"2": {
+			AttributeType: expression.BinarySet,
+			FullName:      "example.message.v1.SetGalore.bytes_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"3": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.int32_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"4": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.int64_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"5": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.uint32_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"6": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.uint64_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"7": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.sint32_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"8": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.sint64_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+		"9": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.SetGalore.fixed32_set",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
+		},
+	})
+}
+
+// PricedPath allows for constructing type-safe expression names
+type PricedPath struct {
+	expression.NameBuilder
+}
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p PricedPath) WithDynamoNameBuilder(n expression.NameBuilder) PricedPath {
+	p.NameBuilder = n
+	return p
+}
+
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p PricedPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.Priced", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p PricedPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.Priced", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p PricedPath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.Priced"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p PricedPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// Price returns 'p' with the attribute name appended and allow updating the value with its codec
+func (p PricedPath) Price() ddbpath.Coded[float64] {
+	return ddbpath.NewCoded[float64](p.AppendName(expression.Name("1")), "cents")
+}
+
+// Discount returns 'p' with the attribute name appended and allow updating the value with its codec
+func (p PricedPath) Discount() ddbpath.Coded[float64] {
+	return ddbpath.NewCoded[float64](p.AppendName(expression.Name("2")), "cents")
+}
+
+// Engine returns 'p' with the attribute name appended and allow updating the value with its codec
+func (p PricedPath) Engine() ddbpath.Coded[proto.Message] {
+	return ddbpath.NewCoded[proto.Message](p.AppendName(expression.Name("3")), "brand")
+}
+
+// Tags returns 'p' with the attribute name appended and allow updating the value with its codec
+func (p PricedPath) Tags() ddbpath.Coded[[]string] {
+	return ddbpath.NewCoded[[]string](p.AppendName(expression.Name("4")), "joined")
+}
+
+// Dirtyness returns 'p' with the attribute name appended and allow updating the value with its codec
+func (p PricedPath) Dirtyness() ddbpath.Coded[protoreflect.Enum] {
+	return ddbpath.NewCoded[protoreflect.Enum](p.AppendName(expression.Name("7")), "decimal")
+}
+
+// Stains returns 'p' with the attribute name appended and allow updating the value with its codec
+func (p PricedPath) Stains() ddbpath.Coded[[]protoreflect.Enum] {
+	return ddbpath.NewCoded[[]protoreflect.Enum](p.AppendName(expression.Name("8")), "decimal")
+}
+
+// PricedDealOneofPath groups the paths of the members of oneof 'deal'
+type PricedDealOneofPath struct {
+	expression.NameBuilder
+}
+
+// Deal returns the path group of the oneof members that sets one member at a time
+func (p PricedPath) Deal() PricedDealOneofPath {
+	return PricedDealOneofPath{NameBuilder: p.NameBuilder}
+}
+
+// SetFixedPrice returns an update that sets member 'fixed_price' to 'v' and removes the other members
+func (p PricedDealOneofPath) SetFixedPrice(v float64) ddbpath.Update {
+	return ddbpath.SetOneof(ddbpath.NewCoded[float64](p.AppendName(expression.Name("5")), "cents").Set(v), p.AppendName(expression.Name("6")))
+}
+
+// WhichFixedPrice returns a condition that checks if 'fixed_price' is the member of the oneof that is set
+func (p PricedDealOneofPath) WhichFixedPrice() expression.ConditionBuilder {
+	return ddbpath.WhichOneof(p.AppendName(expression.Name("5")), p.AppendName(expression.Name("6")))
+}
+
+// PricedFixedPriceMemberPath is the path to member 'fixed_price' of oneof 'deal', its updates remove the other members
+type PricedFixedPriceMemberPath struct {
+	ddbpath.Coded[float64]
+	oneof PricedDealOneofPath
+}
+
+// FixedPrice returns 'p' with the attribute name appended and allow updating it as a member of oneof 'deal'
+func (p PricedPath) FixedPrice() PricedFixedPriceMemberPath {
+	return PricedFixedPriceMemberPath{
+		Coded: ddbpath.NewCoded[float64](p.AppendName(expression.Name("5")), "cents"),
+		oneof: p.Deal(),
+	}
+}
+
+// Set returns the update of the member's path, that also removes the other members
+func (p PricedFixedPriceMemberPath) Set(v float64) ddbpath.Update {
+	return ddbpath.SetOneof(p.Coded.Set(v), p.oneof.AppendName(expression.Name("6")))
+}
+
+// SetIfNotExists returns the update of the member's path, that also removes the other members
+func (p PricedFixedPriceMemberPath) SetIfNotExists(v float64) ddbpath.Update {
+	return ddbpath.SetOneof(p.Coded.SetIfNotExists(v), p.oneof.AppendName(expression.Name("6")))
+}
+
+// SetTradeIn returns an update that sets member 'trade_in' to 'v' and removes the other members
+func (p PricedDealOneofPath) SetTradeIn(v proto.Message) ddbpath.Update {
+	return ddbpath.SetOneof(ddbpath.NewCoded[proto.Message](p.AppendName(expression.Name("6")), "brand").Set(v), p.AppendName(expression.Name("5")))
+}
+
+// WhichTradeIn returns a condition that checks if 'trade_in' is the member of the oneof that is set
+func (p PricedDealOneofPath) WhichTradeIn() expression.ConditionBuilder {
+	return ddbpath.WhichOneof(p.AppendName(expression.Name("6")), p.AppendName(expression.Name("5")))
+}
+
+// PricedTradeInMemberPath is the path to member 'trade_in' of oneof 'deal', its updates remove the other members
+type PricedTradeInMemberPath struct {
+	ddbpath.Coded[proto.Message]
+	oneof PricedDealOneofPath
+}
+
+// TradeIn returns 'p' with the attribute name appended and allow updating it as a member of oneof 'deal'
+func (p PricedPath) TradeIn() PricedTradeInMemberPath {
+	return PricedTradeInMemberPath{
+		Coded: ddbpath.NewCoded[proto.Message](p.AppendName(expression.Name("6")), "brand"),
+		oneof: p.Deal(),
+	}
+}
+
+// Set returns the update of the member's path, that also removes the other members
+func (p PricedTradeInMemberPath) Set(v proto.Message) ddbpath.Update {
+	return ddbpath.SetOneof(p.Coded.Set(v), p.oneof.AppendName(expression.Name("5")))
+}
+
+// SetIfNotExists returns the update of the member's path, that also removes the other members
+func (p PricedTradeInMemberPath) SetIfNotExists(v proto.Message) ddbpath.Update {
+	return ddbpath.SetOneof(p.Coded.SetIfNotExists(v), p.oneof.AppendName(expression.Name("5")))
+}
+func init() {
+	ddbpath.Register(PricedPath{}, map[string]ddbpath.FieldInfo{
+		"1": {
+			Codec:    "cents",
+			FullName: "example.message.v1.Priced.price",
+			Kind:     ddbpath.FieldKindSingle,
+		},
+		"2": {
+			Codec:    "cents",
+			FullName: "example.message.v1.Priced.discount",
+			Kind:     ddbpath.FieldKindSingle,
+			Presence: true,
+		},
+		"3": {
+			Codec:    "brand",
+			FullName: "example.message.v1.Priced.engine",
+			Kind:     ddbpath.FieldKindSingle,
+			Presence: true,
+		},
+		"4": {
+			Codec:    "joined",
+			FullName: "example.message.v1.Priced.tags",
+			Kind:     ddbpath.FieldKindList,
+		},
+		"5": {
+			Codec:    "cents",
+			FullName: "example.message.v1.Priced.fixed_price",
+			Kind:     ddbpath.FieldKindSingle,
+			Presence: true,
+		},
+		"6": {
+			Codec:    "brand",
+			FullName: "example.message.v1.Priced.trade_in",
+			Kind:     ddbpath.FieldKindSingle,
+			Presence: true,
+		},
+		"7": {
+			Codec:    "decimal",
+			FullName: "example.message.v1.Priced.dirtyness",
+			Kind:     ddbpath.FieldKindSingle,
+		},
+		"8": {
+			Codec:    "decimal",
+			FullName: "example.message.v1.Priced.stains",
+			Kind:     ddbpath.FieldKindList,
+		},
+	})
+}
+
+// AmountPath allows for constructing type-safe expression names
+type AmountPath struct {
+	expression.NameBuilder
+}
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p AmountPath) WithDynamoNameBuilder(n expression.NameBuilder) AmountPath {
+	p.NameBuilder = n
+	return p
+}
+
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p AmountPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.Amount", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p AmountPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.Amount", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p AmountPath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.Amount"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p AmountPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// Currency returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p AmountPath) Currency() ddbpath.String {
+	return ddbpath.String{}.WithDynamoNameBuilder(p.AppendName(expression.Name("1")))
+}
+
+// Cents returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p AmountPath) Cents() ddbpath.Number[int64] {
+	return ddbpath.Number[int64]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("2")))
+}
+func init() {
+	ddbpath.Register(AmountPath{}, map[string]ddbpath.FieldInfo{
+		"1": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.Amount.currency",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"2": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.Amount.cents",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+	})
+}
+
+// WalletPath allows for constructing type-safe expression names
+type WalletPath struct {
+	expression.NameBuilder
+}
+
+// WithDynamoNameBuilder allows generic types to overwrite the path
+func (p WalletPath) WithDynamoNameBuilder(n expression.NameBuilder) WalletPath {
+	p.NameBuilder = n
+	return p
+}
+
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p WalletPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.Wallet", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p WalletPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.Wallet", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p WalletPath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.Wallet"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p WalletPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
+// Balance returns 'p' with the attribute name appended and allow subselecting nested message
+func (p WalletPath) Balance() AmountPath {
+	return AmountPath{NameBuilder: p.AppendName(expression.Name("1"))}
+}
+
+// Spent returns 'p' appended with the attribute while allow indexing a nested message
+func (p WalletPath) Spent() ddbpath.ItemList[AmountPath] {
+	return ddbpath.ItemList[AmountPath]{NameBuilder: p.AppendName(expression.Name("2"))}
+}
+
+// Savings returns 'p' appended with the attribute while allow map keys on a nested message
+func (p WalletPath) Savings() ddbpath.ItemMap[AmountPath] {
+	return ddbpath.ItemMap[AmountPath]{NameBuilder: p.AppendName(expression.Name("3"))}
+}
+func init() {
+	ddbpath.Register(WalletPath{}, map[string]ddbpath.FieldInfo{
+		"1": {
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.Wallet.balance",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(AmountPath{}),
+			Presence:      true,
+		},
+		"2": {
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.Wallet.spent",
+			Kind:              ddbpath.FieldKindList,
+			Message:           reflect.TypeOf(AmountPath{}),
+		},
+		"3": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.Wallet.savings",
+			Kind:              ddbpath.FieldKindMap,
+			Message:           reflect.TypeOf(AmountPath{}),
+		},
 	})
 }
@@ -6,6 +6,10 @@ package messagev1ddbpath
 import (
 	expression "github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
 	ddbpath "github.com/crewlinker/protoc-gen-dynamodb/ddb/ddbpath"
+	"github.com/crewlinker/protoc-gen-dynamodb/proto/example/common/v1"
+	"github.com/crewlinker/protoc-gen-dynamodb/proto/example/common/v1/ddbpath"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	"reflect"
 )
 
@@ -20,21 +24,139 @@ func (p OtherKitchenPath) WithDynamoNameBuilder(n expression.NameBuilder) OtherK
 	return p
 }
 
+// DynamoSet returns an update that sets the message at the path to 'x'
+func (p OtherKitchenPath) DynamoSet(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessage(p.NameBuilder, "example.message.v1.OtherKitchen", x)
+}
+
+// DynamoSetIfNotExists returns an update that sets the message at the path to 'x' if it doesn't exist
+func (p OtherKitchenPath) DynamoSetIfNotExists(x proto.Message) ddbpath.Update {
+	return ddbpath.SetMessageIfNotExists(p.NameBuilder, "example.message.v1.OtherKitchen", x)
+}
+
+// DynamoMessageName returns the full name of the message at the path
+func (p OtherKitchenPath) DynamoMessageName() protoreflect.FullName {
+	return "example.message.v1.OtherKitchen"
+}
+
+// DynamoRemove returns an update that removes the message at the path
+func (p OtherKitchenPath) DynamoRemove() ddbpath.Update {
+	return ddbpath.Remove(p.NameBuilder)
+}
+
 // AnotherKitchen returns 'p' with the attribute name appended and allow subselecting nested message
 func (p OtherKitchenPath) AnotherKitchen() KitchenPath {
 	return KitchenPath{NameBuilder: p.AppendName(expression.Name("16"))}
 }
 
-// OtherTimer appends the path being build
-func (p OtherKitchenPath) OtherTimer() expression.NameBuilder {
-	return p.AppendName(expression.Name("17"))
+// OtherTimer returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p OtherKitchenPath) OtherTimer() ddbpath.DurationPath {
+	return ddbpath.DurationPath{NameBuilder: p.AppendName(expression.Name("17"))}
+}
+
+// Address returns 'p' with the attribute name appended and allow subselecting nested message
+func (p OtherKitchenPath) Address() commonv1ddbpath.AddressPath {
+	return commonv1ddbpath.AddressPath{NameBuilder: p.AppendName(expression.Name("18"))}
+}
+
+// Addresses returns 'p' appended with the attribute while allow indexing a nested message
+func (p OtherKitchenPath) Addresses() ddbpath.ItemList[commonv1ddbpath.AddressPath] {
+	return ddbpath.ItemList[commonv1ddbpath.AddressPath]{NameBuilder: p.AppendName(expression.Name("19"))}
+}
+
+// AddressesByName returns 'p' appended with the attribute while allow map keys on a nested message
+func (p OtherKitchenPath) AddressesByName() ddbpath.ItemMap[commonv1ddbpath.AddressPath] {
+	return ddbpath.ItemMap[commonv1ddbpath.AddressPath]{NameBuilder: p.AppendName(expression.Name("20"))}
+}
+
+// Birthday appends the path being build
+func (p OtherKitchenPath) Birthday() expression.NameBuilder {
+	return p.AppendName(expression.Name("21"))
+}
+
+// Holidays returns 'p' appended with the attribute name and allow indexing
+func (p OtherKitchenPath) Holidays() ddbpath.List {
+	return ddbpath.List{NameBuilder: p.AppendName(expression.Name("22"))}
+}
+
+// DatesByName returns 'p' appended with the attribute name and allow map keys to be specified
+func (p OtherKitchenPath) DatesByName() ddbpath.Map {
+	return ddbpath.Map{NameBuilder: p.AppendName(expression.Name("23"))}
+}
+
+// Country returns 'p' with the attribute name appended and allow typed conditions on the value
+func (p OtherKitchenPath) Country() ddbpath.Enum[commonv1.Country] {
+	return ddbpath.Enum[commonv1.Country]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("24")))
+}
+
+// Visited returns 'p' appended with the attribute name and allow updating the set
+func (p OtherKitchenPath) Visited() ddbpath.EnumSet[commonv1.Country] {
+	return ddbpath.EnumSet[commonv1.Country]{}.WithDynamoNameBuilder(p.AppendName(expression.Name("25")))
 }
 func init() {
 	ddbpath.Register(OtherKitchenPath{}, map[string]ddbpath.FieldInfo{
 		"16": {
-			Kind:    ddbpath.FieldKindSingle,
-			Message: reflect.TypeOf(KitchenPath{}),
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.OtherKitchen.another_kitchen",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(KitchenPath{}),
+			Presence:      true,
+		},
+		"17": {
+			AttributeType: expression.String,
+			FullName:      "example.message.v1.OtherKitchen.other_timer",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"18": {
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.OtherKitchen.address",
+			Kind:          ddbpath.FieldKindSingle,
+			Message:       reflect.TypeOf(commonv1ddbpath.AddressPath{}),
+			Presence:      true,
+		},
+		"19": {
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.OtherKitchen.addresses",
+			Kind:              ddbpath.FieldKindList,
+			Message:           reflect.TypeOf(commonv1ddbpath.AddressPath{}),
+		},
+		"20": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.OtherKitchen.addresses_by_name",
+			Kind:              ddbpath.FieldKindMap,
+			Message:           reflect.TypeOf(commonv1ddbpath.AddressPath{}),
+		},
+		"21": {
+			AttributeType: expression.Map,
+			FullName:      "example.message.v1.OtherKitchen.birthday",
+			Kind:          ddbpath.FieldKindSingle,
+			Presence:      true,
+		},
+		"22": {
+			AttributeType:     expression.List,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.OtherKitchen.holidays",
+			Kind:              ddbpath.FieldKindList,
+		},
+		"23": {
+			AttributeType:     expression.Map,
+			ElemAttributeType: expression.Map,
+			FullName:          "example.message.v1.OtherKitchen.dates_by_name",
+			Kind:              ddbpath.FieldKindMap,
+		},
+		"24": {
+			AttributeType: expression.Number,
+			FullName:      "example.message.v1.OtherKitchen.country",
+			Kind:          ddbpath.FieldKindSingle,
+		},
+		"25": {
+			AttributeType: expression.NumberSet,
+			FullName:      "example.message.v1.OtherKitchen.visited",
+			Kind:          ddbpath.FieldKindList,
+			Set:           true,
 		},
-		"17": {Kind: ddbpath.FieldKindSingle},
 	})
 }
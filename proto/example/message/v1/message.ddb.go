@@ -35,6 +35,15 @@ func (x *Engine) MarshalDynamoItem() (m map[string]types.AttributeValue, err err
 	return m, nil
 }
 
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *Engine) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
 // UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
 func (x *Engine) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
 	err = ddb.Unmarshal(m["1"], &x.Brand, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
@@ -73,6 +82,15 @@ func (x *Car) MarshalDynamoItem() (m map[string]types.AttributeValue, err error)
 	return m, nil
 }
 
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *Car) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
 // UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
 func (x *Car) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
 	if m["1"] != nil {
@@ -120,6 +138,15 @@ func (x *Appliance) MarshalDynamoItem() (m map[string]types.AttributeValue, err
 	return m, nil
 }
 
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *Appliance) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
 // UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
 func (x *Appliance) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
 	err = ddb.Unmarshal(m["1"], &x.Brand, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
@@ -141,6 +168,15 @@ func (x *Ignored) MarshalDynamoItem() (m map[string]types.AttributeValue, err er
 	return m, nil
 }
 
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *Ignored) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
 // UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
 func (x *Ignored) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
 	err = ddb.Unmarshal(m["4"], &x.Visible, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
@@ -369,6 +405,15 @@ func (x *Kitchen) MarshalDynamoItem() (m map[string]types.AttributeValue, err er
 	return m, nil
 }
 
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *Kitchen) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
 // UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
 func (x *Kitchen) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
 	err = ddb.Unmarshal(m["1"], &x.Brand, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
@@ -582,6 +627,15 @@ func (x *Empty) MarshalDynamoItem() (m map[string]types.AttributeValue, err erro
 	return m, nil
 }
 
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *Empty) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
 // UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
 func (x *Empty) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
 	return nil
@@ -704,9 +758,72 @@ func (x *MapGalore) MarshalDynamoItem() (m map[string]types.AttributeValue, err
 			return nil, fmt.Errorf("failed to marshal mapped message field 'Uintengine': %w", err)
 		}
 	}
+	if len(x.Int64Enum) != 0 {
+		m["20"], err = ddb.Marshal(x.GetInt64Enum(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Int64Enum': %w", err)
+		}
+	}
+	if len(x.Stringenum) != 0 {
+		m["21"], err = ddb.Marshal(x.GetStringenum(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Stringenum': %w", err)
+		}
+	}
+	if len(x.Uint32Bytes) != 0 {
+		m["22"], err = ddb.Marshal(x.GetUint32Bytes(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Uint32Bytes': %w", err)
+		}
+	}
+	if len(x.Booltimestamp) != 0 {
+		m["23"], err = ddb.MarshalMappedMessage(x.Booltimestamp, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mapped message field 'Booltimestamp': %w", err)
+		}
+	}
+	if len(x.Stringstringvalue) != 0 {
+		m["24"], err = ddb.MarshalMappedMessage(x.Stringstringvalue, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mapped message field 'Stringstringvalue': %w", err)
+		}
+	}
+	if len(x.Stringint64Value) != 0 {
+		m["25"], err = ddb.MarshalMappedMessage(x.Stringint64Value, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mapped message field 'Stringint64Value': %w", err)
+		}
+	}
+	if len(x.Stringboolvalue) != 0 {
+		m["26"], err = ddb.MarshalMappedMessage(x.Stringboolvalue, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mapped message field 'Stringboolvalue': %w", err)
+		}
+	}
+	if len(x.Stringbytesvalue) != 0 {
+		m["27"], err = ddb.MarshalMappedMessage(x.Stringbytesvalue, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mapped message field 'Stringbytesvalue': %w", err)
+		}
+	}
+	if len(x.Stringdoublevalue) != 0 {
+		m["28"], err = ddb.MarshalMappedMessage(x.Stringdoublevalue, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mapped message field 'Stringdoublevalue': %w", err)
+		}
+	}
 	return m, nil
 }
 
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *MapGalore) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
 // UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
 func (x *MapGalore) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
 	err = ddb.Unmarshal(m["1"], &x.Int64Int64, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
@@ -793,6 +910,54 @@ func (x *MapGalore) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err
 			return fmt.Errorf("failed to unmarshal repeated message field 'Uintengine': %w", err)
 		}
 	}
+	err = ddb.Unmarshal(m["20"], &x.Int64Enum, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Int64Enum': %w", err)
+	}
+	err = ddb.Unmarshal(m["21"], &x.Stringenum, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Stringenum': %w", err)
+	}
+	err = ddb.Unmarshal(m["22"], &x.Uint32Bytes, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Uint32Bytes': %w", err)
+	}
+	if m["23"] != nil {
+		x.Booltimestamp, err = ddb.UnmarshalMappedMessage[bool, timestamppb.Timestamp](m["23"], ddb.BoolMapKey, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'Booltimestamp': %w", err)
+		}
+	}
+	if m["24"] != nil {
+		x.Stringstringvalue, err = ddb.UnmarshalMappedMessage[string, wrapperspb.StringValue](m["24"], ddb.StringMapKey, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'Stringstringvalue': %w", err)
+		}
+	}
+	if m["25"] != nil {
+		x.Stringint64Value, err = ddb.UnmarshalMappedMessage[string, wrapperspb.Int64Value](m["25"], ddb.StringMapKey, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'Stringint64Value': %w", err)
+		}
+	}
+	if m["26"] != nil {
+		x.Stringboolvalue, err = ddb.UnmarshalMappedMessage[string, wrapperspb.BoolValue](m["26"], ddb.StringMapKey, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'Stringboolvalue': %w", err)
+		}
+	}
+	if m["27"] != nil {
+		x.Stringbytesvalue, err = ddb.UnmarshalMappedMessage[string, wrapperspb.BytesValue](m["27"], ddb.StringMapKey, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'Stringbytesvalue': %w", err)
+		}
+	}
+	if m["28"] != nil {
+		x.Stringdoublevalue, err = ddb.UnmarshalMappedMessage[string, wrapperspb.DoubleValue](m["28"], ddb.StringMapKey, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'Stringdoublevalue': %w", err)
+		}
+	}
 	return nil
 }
 
@@ -806,9 +971,44 @@ func (x *ValueGalore) MarshalDynamoItem() (m map[string]types.AttributeValue, er
 		}
 		m["1"] = m1
 	}
+	if x.SomeStruct != nil {
+		m2, err := ddb.MarshalMessage(x.GetSomeStruct(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'SomeStruct': %w", err)
+		}
+		m["2"] = m2
+	}
+	if x.SomeList != nil {
+		m3, err := ddb.MarshalMessage(x.GetSomeList(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'SomeList': %w", err)
+		}
+		m["3"] = m3
+	}
+	if len(x.Structs) != 0 {
+		m["4"], err = ddb.MarshalRepeatedMessage(x.Structs, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal repeated message field 'Structs': %w", err)
+		}
+	}
+	if len(x.Lists) != 0 {
+		m["5"], err = ddb.MarshalMappedMessage(x.Lists, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mapped message field 'Lists': %w", err)
+		}
+	}
 	return m, nil
 }
 
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *ValueGalore) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
 // UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
 func (x *ValueGalore) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
 	if m["1"] != nil {
@@ -818,6 +1018,32 @@ func (x *ValueGalore) UnmarshalDynamoItem(m map[string]types.AttributeValue) (er
 			return fmt.Errorf("failed to unmarshal field 'SomeValue': %w", err)
 		}
 	}
+	if m["2"] != nil {
+		x.SomeStruct = new(structpb.Struct)
+		err = ddb.UnmarshalMessage(m["2"], x.SomeStruct, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'SomeStruct': %w", err)
+		}
+	}
+	if m["3"] != nil {
+		x.SomeList = new(structpb.ListValue)
+		err = ddb.UnmarshalMessage(m["3"], x.SomeList, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'SomeList': %w", err)
+		}
+	}
+	if m["4"] != nil {
+		x.Structs, err = ddb.UnmarshalRepeatedMessage[structpb.Struct](m["4"], ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'Structs': %w", err)
+		}
+	}
+	if m["5"] != nil {
+		x.Lists, err = ddb.UnmarshalMappedMessage[string, structpb.ListValue](m["5"], ddb.StringMapKey, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'Lists': %w", err)
+		}
+	}
 	return nil
 }
 
@@ -965,6 +1191,15 @@ func (x *FieldPresence) MarshalDynamoItem() (m map[string]types.AttributeValue,
 	return m, nil
 }
 
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *FieldPresence) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
 // UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
 func (x *FieldPresence) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
 	err = ddb.Unmarshal(m["str"], &x.Str, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
@@ -1143,6 +1378,15 @@ func (x *JsonFields) MarshalDynamoItem() (m map[string]types.AttributeValue, err
 	return m, nil
 }
 
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *JsonFields) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
 // UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
 func (x *JsonFields) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
 	err = ddb.Unmarshal(m["1"], &x.JsonStrList, ddb.Embed(v1.Encoding_ENCODING_JSON))
@@ -1198,6 +1442,15 @@ func (x *JsonOneofs) MarshalDynamoItem() (m map[string]types.AttributeValue, err
 	return m, nil
 }
 
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *JsonOneofs) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
 // UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
 func (x *JsonOneofs) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
 	if m["7"] != nil {
@@ -1219,3 +1472,394 @@ func (x *JsonOneofs) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err
 	}
 	return nil
 }
+
+// MarshalDynamoItem marshals data into a dynamodb attribute map
+func (x *SetGalore) MarshalDynamoItem() (m map[string]types.AttributeValue, err error) {
+	m = make(map[string]types.AttributeValue)
+	if len(x.StringSet) != 0 {
+		m["1"], err = ddb.MarshalSet(x.StringSet, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'StringSet': %w", err)
+		}
+	}
+	if len(x.BytesSet) != 0 {
+		m["2"], err = ddb.MarshalSet(x.BytesSet, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'BytesSet': %w", err)
+		}
+	}
+	if len(x.Int32Set) != 0 {
+		m["3"], err = ddb.MarshalSet(x.Int32Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'Int32Set': %w", err)
+		}
+	}
+	if len(x.Int64Set) != 0 {
+		m["4"], err = ddb.MarshalSet(x.Int64Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'Int64Set': %w", err)
+		}
+	}
+	if len(x.Uint32Set) != 0 {
+		m["5"], err = ddb.MarshalSet(x.Uint32Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'Uint32Set': %w", err)
+		}
+	}
+	if len(x.Uint64Set) != 0 {
+		m["6"], err = ddb.MarshalSet(x.Uint64Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'Uint64Set': %w", err)
+		}
+	}
+	if len(x.Sint32Set) != 0 {
+		m["7"], err = ddb.MarshalSet(x.Sint32Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'Sint32Set': %w", err)
+		}
+	}
+	if len(x.Sint64Set) != 0 {
+		m["8"], err = ddb.MarshalSet(x.Sint64Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'Sint64Set': %w", err)
+		}
+	}
+	if len(x.Fixed32Set) != 0 {
+		m["9"], err = ddb.MarshalSet(x.Fixed32Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'Fixed32Set': %w", err)
+		}
+	}
+	if len(x.Fixed64Set) != 0 {
+		m["10"], err = ddb.MarshalSet(x.Fixed64Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'Fixed64Set': %w", err)
+		}
+	}
+	if len(x.Sfixed32Set) != 0 {
+		m["11"], err = ddb.MarshalSet(x.Sfixed32Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'Sfixed32Set': %w", err)
+		}
+	}
+	if len(x.Sfixed64Set) != 0 {
+		m["12"], err = ddb.MarshalSet(x.Sfixed64Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'Sfixed64Set': %w", err)
+		}
+	}
+	if len(x.FloatSet) != 0 {
+		m["13"], err = ddb.MarshalSet(x.FloatSet, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'FloatSet': %w", err)
+		}
+	}
+	if len(x.DoubleSet) != 0 {
+		m["14"], err = ddb.MarshalSet(x.DoubleSet, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'DoubleSet': %w", err)
+		}
+	}
+	if len(x.EnumSet) != 0 {
+		m["15"], err = ddb.MarshalSet(x.EnumSet, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'EnumSet': %w", err)
+		}
+	}
+	return m, nil
+}
+
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *SetGalore) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
+// UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
+func (x *SetGalore) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
+	err = ddb.Unmarshal(m["1"], &x.StringSet, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'StringSet': %w", err)
+	}
+	err = ddb.Unmarshal(m["2"], &x.BytesSet, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'BytesSet': %w", err)
+	}
+	err = ddb.Unmarshal(m["3"], &x.Int32Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Int32Set': %w", err)
+	}
+	err = ddb.Unmarshal(m["4"], &x.Int64Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Int64Set': %w", err)
+	}
+	err = ddb.Unmarshal(m["5"], &x.Uint32Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Uint32Set': %w", err)
+	}
+	err = ddb.Unmarshal(m["6"], &x.Uint64Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Uint64Set': %w", err)
+	}
+	err = ddb.Unmarshal(m["7"], &x.Sint32Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Sint32Set': %w", err)
+	}
+	err = ddb.Unmarshal(m["8"], &x.Sint64Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Sint64Set': %w", err)
+	}
+	err = ddb.Unmarshal(m["9"], &x.Fixed32Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Fixed32Set': %w", err)
+	}
+	err = ddb.Unmarshal(m["10"], &x.Fixed64Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Fixed64Set': %w", err)
+	}
+	err = ddb.Unmarshal(m["11"], &x.Sfixed32Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Sfixed32Set': %w", err)
+	}
+	err = ddb.Unmarshal(m["12"], &x.Sfixed64Set, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Sfixed64Set': %w", err)
+	}
+	err = ddb.Unmarshal(m["13"], &x.FloatSet, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'FloatSet': %w", err)
+	}
+	err = ddb.Unmarshal(m["14"], &x.DoubleSet, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'DoubleSet': %w", err)
+	}
+	err = ddb.Unmarshal(m["15"], &x.EnumSet, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'EnumSet': %w", err)
+	}
+	return nil
+}
+
+// MarshalDynamoItem marshals data into a dynamodb attribute map
+func (x *Priced) MarshalDynamoItem() (m map[string]types.AttributeValue, err error) {
+	m = make(map[string]types.AttributeValue)
+	if x.Price != 0 {
+		m["1"], err = ddb.MarshalCodec("cents", x.GetPrice())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Price': %w", err)
+		}
+	}
+	if x.Discount != nil {
+		m["2"], err = ddb.MarshalCodec("cents", x.GetDiscount())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Discount': %w", err)
+		}
+	}
+	if x.Engine != nil {
+		m["3"], err = ddb.MarshalCodec("brand", x.GetEngine())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Engine': %w", err)
+		}
+	}
+	if len(x.Tags) != 0 {
+		m["4"], err = ddb.MarshalCodec("joined", x.GetTags())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Tags': %w", err)
+		}
+	}
+	if onev, ok := x.Deal.(*Priced_FixedPrice); ok && onev != nil {
+		m["5"], err = ddb.MarshalCodec("cents", x.GetFixedPrice())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'FixedPrice': %w", err)
+		}
+	}
+	if onev, ok := x.Deal.(*Priced_TradeIn); ok && onev != nil {
+		m["6"], err = ddb.MarshalCodec("brand", x.GetTradeIn())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'TradeIn': %w", err)
+		}
+	}
+	if x.Dirtyness != 0 {
+		m["7"], err = ddb.MarshalCodec("decimal", x.GetDirtyness())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Dirtyness': %w", err)
+		}
+	}
+	if len(x.Stains) != 0 {
+		m["8"], err = ddb.MarshalCodec("decimal", x.GetStains())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Stains': %w", err)
+		}
+	}
+	return m, nil
+}
+
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *Priced) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
+// UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
+func (x *Priced) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
+	if m["1"] != nil {
+		err = ddb.UnmarshalCodec("cents", m["1"], &x.Price)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'Price': %w", err)
+		}
+	}
+	if m["2"] != nil {
+		x.Discount = new(float64)
+		err = ddb.UnmarshalCodec("cents", m["2"], x.Discount)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'Discount': %w", err)
+		}
+	}
+	if m["3"] != nil {
+		x.Engine = new(Engine)
+		err = ddb.UnmarshalCodec("brand", m["3"], x.Engine)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'Engine': %w", err)
+		}
+	}
+	if m["4"] != nil {
+		err = ddb.UnmarshalCodec("joined", m["4"], &x.Tags)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'Tags': %w", err)
+		}
+	}
+	if m["5"] != nil {
+		var mo Priced_FixedPrice
+		err = ddb.UnmarshalCodec("cents", m["5"], &mo.FixedPrice)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'FixedPrice': %w", err)
+		}
+		x.Deal = &mo
+	}
+	if m["6"] != nil {
+		var mo Priced_TradeIn
+		mo.TradeIn = new(Engine)
+		err = ddb.UnmarshalCodec("brand", m["6"], mo.TradeIn)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'TradeIn': %w", err)
+		}
+		x.Deal = &mo
+	}
+	if m["7"] != nil {
+		err = ddb.UnmarshalCodec("decimal", m["7"], &x.Dirtyness)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'Dirtyness': %w", err)
+		}
+	}
+	if m["8"] != nil {
+		err = ddb.UnmarshalCodec("decimal", m["8"], &x.Stains)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'Stains': %w", err)
+		}
+	}
+	return nil
+}
+
+// MarshalDynamoItem marshals data into a dynamodb attribute map
+func (x *Amount) MarshalDynamoItem() (m map[string]types.AttributeValue, err error) {
+	m = make(map[string]types.AttributeValue)
+	if x.Currency != "" {
+		m["1"], err = ddb.Marshal(x.GetCurrency(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Currency': %w", err)
+		}
+	}
+	if x.Cents != 0 {
+		m["2"], err = ddb.Marshal(x.GetCents(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Cents': %w", err)
+		}
+	}
+	return m, nil
+}
+
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *Amount) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
+// UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
+func (x *Amount) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
+	err = ddb.Unmarshal(m["1"], &x.Currency, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Currency': %w", err)
+	}
+	err = ddb.Unmarshal(m["2"], &x.Cents, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Cents': %w", err)
+	}
+	return nil
+}
+
+// MarshalDynamoItem marshals data into a dynamodb attribute map
+func (x *Wallet) MarshalDynamoItem() (m map[string]types.AttributeValue, err error) {
+	m = make(map[string]types.AttributeValue)
+	if x.Balance != nil {
+		m1, err := ddb.MarshalMessage(x.GetBalance(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Balance': %w", err)
+		}
+		m["1"] = m1
+	}
+	if len(x.Spent) != 0 {
+		m["2"], err = ddb.MarshalRepeatedMessage(x.Spent, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal repeated message field 'Spent': %w", err)
+		}
+	}
+	if len(x.Savings) != 0 {
+		m["3"], err = ddb.MarshalMappedMessage(x.Savings, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mapped message field 'Savings': %w", err)
+		}
+	}
+	return m, nil
+}
+
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *Wallet) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
+// UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
+func (x *Wallet) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
+	if m["1"] != nil {
+		x.Balance = new(Amount)
+		err = ddb.UnmarshalMessage(m["1"], x.Balance, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'Balance': %w", err)
+		}
+	}
+	if m["2"] != nil {
+		x.Spent, err = ddb.UnmarshalRepeatedMessage[Amount](m["2"], ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'Spent': %w", err)
+		}
+	}
+	if m["3"] != nil {
+		x.Savings, err = ddb.UnmarshalMappedMessage[string, Amount](m["3"], ddb.StringMapKey, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'Savings': %w", err)
+		}
+	}
+	return nil
+}
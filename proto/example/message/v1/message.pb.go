@@ -758,6 +758,24 @@ type MapGalore struct {
 	Boolengine map[bool]*Engine `protobuf:"bytes,18,rep,name=boolengine,proto3" json:"boolengine,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// maps to messages
 	Uintengine map[uint64]*Engine `protobuf:"bytes,19,rep,name=uintengine,proto3" json:"uintengine,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// int64/enum
+	Int64Enum map[int64]Dirtyness `protobuf:"bytes,20,rep,name=int64enum,proto3" json:"int64enum,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=example.message.v1.Dirtyness"`
+	// string/enum
+	Stringenum map[string]Dirtyness `protobuf:"bytes,21,rep,name=stringenum,proto3" json:"stringenum,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=example.message.v1.Dirtyness"`
+	// uint32/bytes
+	Uint32Bytes map[uint32][]byte `protobuf:"bytes,22,rep,name=uint32bytes,proto3" json:"uint32bytes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// bool/timestamp
+	Booltimestamp map[bool]*timestamppb.Timestamp `protobuf:"bytes,23,rep,name=booltimestamp,proto3" json:"booltimestamp,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// string/string wrapper
+	Stringstringvalue map[string]*wrapperspb.StringValue `protobuf:"bytes,24,rep,name=stringstringvalue,proto3" json:"stringstringvalue,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// string/int64 wrapper
+	Stringint64Value map[string]*wrapperspb.Int64Value `protobuf:"bytes,25,rep,name=stringint64value,proto3" json:"stringint64value,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// string/bool wrapper
+	Stringboolvalue map[string]*wrapperspb.BoolValue `protobuf:"bytes,26,rep,name=stringboolvalue,proto3" json:"stringboolvalue,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// string/bytes wrapper
+	Stringbytesvalue map[string]*wrapperspb.BytesValue `protobuf:"bytes,27,rep,name=stringbytesvalue,proto3" json:"stringbytesvalue,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// string/double wrapper
+	Stringdoublevalue map[string]*wrapperspb.DoubleValue `protobuf:"bytes,28,rep,name=stringdoublevalue,proto3" json:"stringdoublevalue,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *MapGalore) Reset() {
@@ -925,6 +943,69 @@ func (x *MapGalore) GetUintengine() map[uint64]*Engine {
 	return nil
 }
 
+func (x *MapGalore) GetInt64Enum() map[int64]Dirtyness {
+	if x != nil {
+		return x.Int64Enum
+	}
+	return nil
+}
+
+func (x *MapGalore) GetStringenum() map[string]Dirtyness {
+	if x != nil {
+		return x.Stringenum
+	}
+	return nil
+}
+
+func (x *MapGalore) GetUint32Bytes() map[uint32][]byte {
+	if x != nil {
+		return x.Uint32Bytes
+	}
+	return nil
+}
+
+func (x *MapGalore) GetBooltimestamp() map[bool]*timestamppb.Timestamp {
+	if x != nil {
+		return x.Booltimestamp
+	}
+	return nil
+}
+
+func (x *MapGalore) GetStringstringvalue() map[string]*wrapperspb.StringValue {
+	if x != nil {
+		return x.Stringstringvalue
+	}
+	return nil
+}
+
+func (x *MapGalore) GetStringint64Value() map[string]*wrapperspb.Int64Value {
+	if x != nil {
+		return x.Stringint64Value
+	}
+	return nil
+}
+
+func (x *MapGalore) GetStringboolvalue() map[string]*wrapperspb.BoolValue {
+	if x != nil {
+		return x.Stringboolvalue
+	}
+	return nil
+}
+
+func (x *MapGalore) GetStringbytesvalue() map[string]*wrapperspb.BytesValue {
+	if x != nil {
+		return x.Stringbytesvalue
+	}
+	return nil
+}
+
+func (x *MapGalore) GetStringdoublevalue() map[string]*wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Stringdoublevalue
+	}
+	return nil
+}
+
 // Message for testing structpb value marshalling
 type ValueGalore struct {
 	state         protoimpl.MessageState
@@ -933,6 +1014,14 @@ type ValueGalore struct {
 
 	// struct value
 	SomeValue *structpb.Value `protobuf:"bytes,1,opt,name=some_value,json=someValue,proto3" json:"some_value,omitempty"`
+	// struct
+	SomeStruct *structpb.Struct `protobuf:"bytes,2,opt,name=some_struct,json=someStruct,proto3" json:"some_struct,omitempty"`
+	// list value
+	SomeList *structpb.ListValue `protobuf:"bytes,3,opt,name=some_list,json=someList,proto3" json:"some_list,omitempty"`
+	// list of structs
+	Structs []*structpb.Struct `protobuf:"bytes,4,rep,name=structs,proto3" json:"structs,omitempty"`
+	// map of list values
+	Lists map[string]*structpb.ListValue `protobuf:"bytes,5,rep,name=lists,proto3" json:"lists,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *ValueGalore) Reset() {
@@ -974,6 +1063,34 @@ func (x *ValueGalore) GetSomeValue() *structpb.Value {
 	return nil
 }
 
+func (x *ValueGalore) GetSomeStruct() *structpb.Struct {
+	if x != nil {
+		return x.SomeStruct
+	}
+	return nil
+}
+
+func (x *ValueGalore) GetSomeList() *structpb.ListValue {
+	if x != nil {
+		return x.SomeList
+	}
+	return nil
+}
+
+func (x *ValueGalore) GetStructs() []*structpb.Struct {
+	if x != nil {
+		return x.Structs
+	}
+	return nil
+}
+
+func (x *ValueGalore) GetLists() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
 // FieldPresence message is used to experiment and assert field presence in the generated
 // attribute map
 type FieldPresence struct {
@@ -1412,6 +1529,446 @@ func (*JsonOneofs_OneofStr) isJsonOneofs_JsonOo() {}
 
 func (*JsonOneofs_OneofMsg) isJsonOneofs_JsonOo() {}
 
+// SetGalore holds a set of every kind that can be stored as a set
+type SetGalore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// set of strings
+	StringSet []string `protobuf:"bytes,1,rep,name=string_set,json=stringSet,proto3" json:"string_set,omitempty"`
+	// set of bytes
+	BytesSet [][]byte `protobuf:"bytes,2,rep,name=bytes_set,json=bytesSet,proto3" json:"bytes_set,omitempty"`
+	// set of int32
+	Int32Set []int32 `protobuf:"varint,3,rep,packed,name=int32_set,json=int32Set,proto3" json:"int32_set,omitempty"`
+	// set of int64
+	Int64Set []int64 `protobuf:"varint,4,rep,packed,name=int64_set,json=int64Set,proto3" json:"int64_set,omitempty"`
+	// set of uint32
+	Uint32Set []uint32 `protobuf:"varint,5,rep,packed,name=uint32_set,json=uint32Set,proto3" json:"uint32_set,omitempty"`
+	// set of uint64
+	Uint64Set []uint64 `protobuf:"varint,6,rep,packed,name=uint64_set,json=uint64Set,proto3" json:"uint64_set,omitempty"`
+	// set of sint32
+	Sint32Set []int32 `protobuf:"zigzag32,7,rep,packed,name=sint32_set,json=sint32Set,proto3" json:"sint32_set,omitempty"`
+	// set of sint64
+	Sint64Set []int64 `protobuf:"zigzag64,8,rep,packed,name=sint64_set,json=sint64Set,proto3" json:"sint64_set,omitempty"`
+	// set of fixed32
+	Fixed32Set []uint32 `protobuf:"fixed32,9,rep,packed,name=fixed32_set,json=fixed32Set,proto3" json:"fixed32_set,omitempty"`
+	// set of fixed64
+	Fixed64Set []uint64 `protobuf:"fixed64,10,rep,packed,name=fixed64_set,json=fixed64Set,proto3" json:"fixed64_set,omitempty"`
+	// set of sfixed32
+	Sfixed32Set []int32 `protobuf:"fixed32,11,rep,packed,name=sfixed32_set,json=sfixed32Set,proto3" json:"sfixed32_set,omitempty"`
+	// set of sfixed64
+	Sfixed64Set []int64 `protobuf:"fixed64,12,rep,packed,name=sfixed64_set,json=sfixed64Set,proto3" json:"sfixed64_set,omitempty"`
+	// set of floats
+	FloatSet []float32 `protobuf:"fixed32,13,rep,packed,name=float_set,json=floatSet,proto3" json:"float_set,omitempty"`
+	// set of doubles
+	DoubleSet []float64 `protobuf:"fixed64,14,rep,packed,name=double_set,json=doubleSet,proto3" json:"double_set,omitempty"`
+	// set of enums
+	EnumSet []Dirtyness `protobuf:"varint,15,rep,packed,name=enum_set,json=enumSet,proto3,enum=example.message.v1.Dirtyness" json:"enum_set,omitempty"`
+}
+
+func (x *SetGalore) Reset() {
+	*x = SetGalore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_message_v1_message_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGalore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGalore) ProtoMessage() {}
+
+func (x *SetGalore) ProtoReflect() protoreflect.Message {
+	mi := &file_example_message_v1_message_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGalore.ProtoReflect.Descriptor instead.
+func (*SetGalore) Descriptor() ([]byte, []int) {
+	return file_example_message_v1_message_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetGalore) GetStringSet() []string {
+	if x != nil {
+		return x.StringSet
+	}
+	return nil
+}
+
+func (x *SetGalore) GetBytesSet() [][]byte {
+	if x != nil {
+		return x.BytesSet
+	}
+	return nil
+}
+
+func (x *SetGalore) GetInt32Set() []int32 {
+	if x != nil {
+		return x.Int32Set
+	}
+	return nil
+}
+
+func (x *SetGalore) GetInt64Set() []int64 {
+	if x != nil {
+		return x.Int64Set
+	}
+	return nil
+}
+
+func (x *SetGalore) GetUint32Set() []uint32 {
+	if x != nil {
+		return x.Uint32Set
+	}
+	return nil
+}
+
+func (x *SetGalore) GetUint64Set() []uint64 {
+	if x != nil {
+		return x.Uint64Set
+	}
+	return nil
+}
+
+func (x *SetGalore) GetSint32Set() []int32 {
+	if x != nil {
+		return x.Sint32Set
+	}
+	return nil
+}
+
+func (x *SetGalore) GetSint64Set() []int64 {
+	if x != nil {
+		return x.Sint64Set
+	}
+	return nil
+}
+
+func (x *SetGalore) GetFixed32Set() []uint32 {
+	if x != nil {
+		return x.Fixed32Set
+	}
+	return nil
+}
+
+func (x *SetGalore) GetFixed64Set() []uint64 {
+	if x != nil {
+		return x.Fixed64Set
+	}
+	return nil
+}
+
+func (x *SetGalore) GetSfixed32Set() []int32 {
+	if x != nil {
+		return x.Sfixed32Set
+	}
+	return nil
+}
+
+func (x *SetGalore) GetSfixed64Set() []int64 {
+	if x != nil {
+		return x.Sfixed64Set
+	}
+	return nil
+}
+
+func (x *SetGalore) GetFloatSet() []float32 {
+	if x != nil {
+		return x.FloatSet
+	}
+	return nil
+}
+
+func (x *SetGalore) GetDoubleSet() []float64 {
+	if x != nil {
+		return x.DoubleSet
+	}
+	return nil
+}
+
+func (x *SetGalore) GetEnumSet() []Dirtyness {
+	if x != nil {
+		return x.EnumSet
+	}
+	return nil
+}
+
+// Priced stores fields with codecs, the codecs are registered by the tests
+type Priced struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// price in euros, stored as a number of cents
+	Price float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	// optional discount in euros, stored as a number of cents
+	Discount *float64 `protobuf:"fixed64,2,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
+	// engine, stored as its brand
+	Engine *Engine `protobuf:"bytes,3,opt,name=engine,proto3" json:"engine,omitempty"`
+	// tags, stored as a single comma separated string
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// deal that was made
+	//
+	// Types that are assignable to Deal:
+	//
+	//	*Priced_FixedPrice
+	//	*Priced_TradeIn
+	Deal isPriced_Deal `protobuf_oneof:"deal"`
+	// dirtyness, stored as a decimal string
+	Dirtyness Dirtyness `protobuf:"varint,7,opt,name=dirtyness,proto3,enum=example.message.v1.Dirtyness" json:"dirtyness,omitempty"`
+	// stains, stored as decimal strings
+	Stains []Dirtyness `protobuf:"varint,8,rep,packed,name=stains,proto3,enum=example.message.v1.Dirtyness" json:"stains,omitempty"`
+}
+
+func (x *Priced) Reset() {
+	*x = Priced{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_message_v1_message_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Priced) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Priced) ProtoMessage() {}
+
+func (x *Priced) ProtoReflect() protoreflect.Message {
+	mi := &file_example_message_v1_message_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Priced.ProtoReflect.Descriptor instead.
+func (*Priced) Descriptor() ([]byte, []int) {
+	return file_example_message_v1_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Priced) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Priced) GetDiscount() float64 {
+	if x != nil && x.Discount != nil {
+		return *x.Discount
+	}
+	return 0
+}
+
+func (x *Priced) GetEngine() *Engine {
+	if x != nil {
+		return x.Engine
+	}
+	return nil
+}
+
+func (x *Priced) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (m *Priced) GetDeal() isPriced_Deal {
+	if m != nil {
+		return m.Deal
+	}
+	return nil
+}
+
+func (x *Priced) GetFixedPrice() float64 {
+	if x, ok := x.GetDeal().(*Priced_FixedPrice); ok {
+		return x.FixedPrice
+	}
+	return 0
+}
+
+func (x *Priced) GetTradeIn() *Engine {
+	if x, ok := x.GetDeal().(*Priced_TradeIn); ok {
+		return x.TradeIn
+	}
+	return nil
+}
+
+func (x *Priced) GetDirtyness() Dirtyness {
+	if x != nil {
+		return x.Dirtyness
+	}
+	return Dirtyness_DIRTYNESS_UNSPECIFIED
+}
+
+func (x *Priced) GetStains() []Dirtyness {
+	if x != nil {
+		return x.Stains
+	}
+	return nil
+}
+
+type isPriced_Deal interface {
+	isPriced_Deal()
+}
+
+type Priced_FixedPrice struct {
+	// fixed price in euros, stored as a number of cents
+	FixedPrice float64 `protobuf:"fixed64,5,opt,name=fixed_price,json=fixedPrice,proto3,oneof"`
+}
+
+type Priced_TradeIn struct {
+	// engine that was traded in, stored as its brand
+	TradeIn *Engine `protobuf:"bytes,6,opt,name=trade_in,json=tradeIn,proto3,oneof"`
+}
+
+func (*Priced_FixedPrice) isPriced_Deal() {}
+
+func (*Priced_TradeIn) isPriced_Deal() {}
+
+// Amount is a value type, the tests register it as a well-known that is stored as a string
+type Amount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// currency code of the amount
+	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	// amount in cents
+	Cents int64 `protobuf:"varint,2,opt,name=cents,proto3" json:"cents,omitempty"`
+}
+
+func (x *Amount) Reset() {
+	*x = Amount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_message_v1_message_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Amount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Amount) ProtoMessage() {}
+
+func (x *Amount) ProtoReflect() protoreflect.Message {
+	mi := &file_example_message_v1_message_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Amount.ProtoReflect.Descriptor instead.
+func (*Amount) Descriptor() ([]byte, []int) {
+	return file_example_message_v1_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Amount) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Amount) GetCents() int64 {
+	if x != nil {
+		return x.Cents
+	}
+	return 0
+}
+
+// Wallet holds amounts in every kind of field
+type Wallet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// current balance
+	Balance *Amount `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// amounts that were spent
+	Spent []*Amount `protobuf:"bytes,2,rep,name=spent,proto3" json:"spent,omitempty"`
+	// amounts saved per goal
+	Savings map[string]*Amount `protobuf:"bytes,3,rep,name=savings,proto3" json:"savings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Wallet) Reset() {
+	*x = Wallet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_message_v1_message_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Wallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wallet) ProtoMessage() {}
+
+func (x *Wallet) ProtoReflect() protoreflect.Message {
+	mi := &file_example_message_v1_message_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
+func (*Wallet) Descriptor() ([]byte, []int) {
+	return file_example_message_v1_message_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Wallet) GetBalance() *Amount {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *Wallet) GetSpent() []*Amount {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+func (x *Wallet) GetSavings() map[string]*Amount {
+	if x != nil {
+		return x.Savings
+	}
+	return nil
+}
+
 var File_example_message_v1_message_proto protoreflect.FileDescriptor
 
 var file_example_message_v1_message_proto_rawDesc = []byte{
@@ -1454,495 +2011,709 @@ var file_example_message_v1_message_proto_rawDesc = []byte{
 	0x6b, 0x12, 0x1b, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x05, 0xd2, 0x44, 0x02, 0x20, 0x01, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x12, 0x18,
 	0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0xb9, 0x10, 0x0a, 0x07, 0x4b, 0x69, 0x74,
-	0x63, 0x68, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x10, 0x01, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x6e, 0x6f, 0x76, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x52, 0x65, 0x6e, 0x6f, 0x76,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x07, 0x71, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x18, 0x01, 0x52, 0x06, 0x71, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x6d, 0x61, 0x6c,
-	0x6c, 0x5f, 0x6b, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
-	0x6e, 0x75, 0x6d, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x4b, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x12, 0x28,
-	0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x70, 0x5f, 0x6b, 0x6e, 0x69, 0x66,
-	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x07, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x68, 0x61,
-	0x72, 0x70, 0x4b, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f,
-	0x62, 0x6c, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x42, 0x6c, 0x75, 0x6e, 0x74, 0x4b, 0x6e, 0x69, 0x66,
-	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x5f,
-	0x66, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x75, 0x6d,
-	0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75,
-	0x6d, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x06, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x46,
-	0x6f, 0x72, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x61, 0x72, 0x67,
-	0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e,
-	0x75, 0x6d, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x13,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x69,
-	0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x70, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x69,
-	0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x57, 0x68, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x09,
-	0x64, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x09,
-	0x64, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x66, 0x75, 0x72,
-	0x6e, 0x69, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x46, 0x75, 0x72, 0x6e, 0x69, 0x74,
-	0x75, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x66, 0x75, 0x72, 0x6e, 0x69, 0x74,
-	0x75, 0x72, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18,
-	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0xe2, 0x10, 0x0a, 0x07, 0x4b, 0x69, 0x74,
+	0x63, 0x68, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0f, 0xd2, 0x44, 0x0c, 0x10, 0x01, 0x42, 0x08, 0x62, 0x79, 0x5f, 0x66,
+	0x6f, 0x72, 0x6b, 0x73, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x73, 0x5f, 0x72, 0x65, 0x6e, 0x6f, 0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x52, 0x65, 0x6e, 0x6f, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x07, 0x71, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x05, 0xd2, 0x44, 0x02, 0x18, 0x01, 0x52, 0x06, 0x71, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x38,
+	0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x5f, 0x6b, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0xd2, 0x44, 0x0b, 0x4a, 0x09, 0x62,
+	0x79, 0x5f, 0x6b, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x6d, 0x61,
+	0x6c, 0x6c, 0x4b, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f,
+	0x73, 0x68, 0x61, 0x72, 0x70, 0x5f, 0x6b, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x07, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x70, 0x4b, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x75, 0x6e, 0x74, 0x5f,
+	0x6b, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x75,
+	0x6d, 0x42, 0x6c, 0x75, 0x6e, 0x74, 0x4b, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0f,
+	0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x3a, 0x08, 0x62, 0x79, 0x5f, 0x66,
+	0x6f, 0x72, 0x6b, 0x73, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x46, 0x6f,
+	0x72, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x75,
+	0x6d, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x06, 0x52, 0x0e, 0x6e,
+	0x75, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x4c, 0x61, 0x72, 0x67, 0x65,
+	0x46, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x5f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x11, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b,
+	0x54, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x11, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x57, 0x68, 0x69, 0x74, 0x65,
+	0x54, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65,
+	0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x09, 0x64, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65,
+	0x73, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x66, 0x75, 0x72, 0x6e, 0x69, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
 	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68,
-	0x65, 0x6e, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x3f, 0x0a, 0x0d, 0x77, 0x61,
-	0x73, 0x68, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0c, 0x77,
-	0x61, 0x73, 0x68, 0x65, 0x72, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x5f, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x52,
-	0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x12, 0x2f, 0x0a,
-	0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x37,
-	0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x77,
-	0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x10,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x73,
-	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x73,
-	0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x42, 0x72, 0x61,
-	0x6e, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x79, 0x18,
-	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x73, 0x6f, 0x6d,
-	0x65, 0x41, 0x6e, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x73,
-	0x6b, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
-	0x61, 0x73, 0x6b, 0x52, 0x08, 0x73, 0x6f, 0x6d, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x35, 0x0a,
-	0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x70, 0x74, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x07, 0x76, 0x61, 0x6c, 0x5f,
-	0x73, 0x74, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x12,
-	0x38, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x1a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x08, 0x76, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x4f,
-	0x66, 0x54, 0x73, 0x12, 0x24, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65,
-	0x74, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x09, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28, 0x01, 0x52, 0x09,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x03, 0x42, 0x05, 0xd2,
-	0x44, 0x02, 0x28, 0x01, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12,
-	0x22, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x1e, 0x20, 0x03,
-	0x28, 0x0c, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28, 0x01, 0x52, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x53, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x6e, 0x79, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
-	0x0b, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x79, 0x12, 0x49, 0x0a, 0x0a,
-	0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x79, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x4d, 0x61,
-	0x70, 0x70, 0x65, 0x64, 0x41, 0x6e, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6d, 0x61,
-	0x70, 0x70, 0x65, 0x64, 0x41, 0x6e, 0x79, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x66, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0d, 0x72, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x4f, 0x0a, 0x0c, 0x6d, 0x61,
-	0x70, 0x70, 0x65, 0x64, 0x5f, 0x66, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x22, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x4d, 0x61,
-	0x70, 0x70, 0x65, 0x64, 0x46, 0x6d, 0x61, 0x73, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
-	0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x46, 0x6d, 0x61, 0x73, 0x6b, 0x1a, 0x5b, 0x0a, 0x0e, 0x46,
-	0x75, 0x72, 0x6e, 0x69, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x65, 0x6e, 0x2e, 0x46, 0x75, 0x72, 0x6e, 0x69, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x09, 0x66, 0x75, 0x72, 0x6e, 0x69, 0x74, 0x75, 0x72, 0x65, 0x12, 0x45, 0x0a, 0x08,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
 	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x65,
-	0x6e, 0x64, 0x61, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a, 0x0e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x41,
-	0x6e, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5a, 0x0a, 0x10, 0x4d, 0x61, 0x70,
-	0x70, 0x65, 0x64, 0x46, 0x6d, 0x61, 0x73, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x12, 0x3f, 0x0a, 0x0d, 0x77, 0x61, 0x73, 0x68, 0x65, 0x72, 0x5f, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0c, 0x77, 0x61, 0x73, 0x68, 0x65, 0x72, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x6b, 0x69,
+	0x74, 0x63, 0x68, 0x65, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x47, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x74, 0x68,
+	0x65, 0x72, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x08,
+	0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6f, 0x70, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xa8, 0x17,
-	0x0a, 0x09, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x49,
-	0x6e, 0x74, 0x36, 0x34, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x53, 0x0a, 0x0c, 0x75, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e,
-	0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12,
-	0x59, 0x0a, 0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70,
-	0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x53, 0x0a, 0x0c, 0x73, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x73, 0x6f, 0x6d, 0x65, 0x41, 0x6e, 0x79, 0x12, 0x37, 0x0a,
+	0x09, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x73, 0x6f,
+	0x6d, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a,
+	0x0a, 0x6f, 0x70, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x88, 0x01,
+	0x01, 0x12, 0x35, 0x0a, 0x07, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x73,
+	0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x54, 0x73, 0x12, 0x24, 0x0a, 0x0a,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x05, 0xd2, 0x44, 0x02, 0x28, 0x01, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x1d, 0x20, 0x03, 0x28, 0x03, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28, 0x01, 0x52, 0x09, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x05, 0xd2, 0x44, 0x02,
+	0x28, 0x01, 0x52, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x0c,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x79, 0x18, 0x1f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x6e, 0x79, 0x12, 0x49, 0x0a, 0x0a, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f,
+	0x61, 0x6e, 0x79, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x41, 0x6e, 0x79,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x41, 0x6e, 0x79,
+	0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6d, 0x61,
+	0x73, 0x6b, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6d,
+	0x61, 0x73, 0x6b, 0x12, 0x4f, 0x0a, 0x0c, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x66, 0x6d,
+	0x61, 0x73, 0x6b, 0x18, 0x22, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x46, 0x6d, 0x61,
+	0x73, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x46,
+	0x6d, 0x61, 0x73, 0x6b, 0x1a, 0x5b, 0x0a, 0x0e, 0x46, 0x75, 0x72, 0x6e, 0x69, 0x74, 0x75, 0x72,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52,
+	0x0a, 0x0e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x41, 0x6e, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x5a, 0x0a, 0x10, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x46, 0x6d, 0x61, 0x73,
+	0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x6f, 0x70, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x07, 0x0a,
+	0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x9a, 0x24, 0x0a, 0x09, 0x4d, 0x61, 0x70, 0x47, 0x61,
+	0x6c, 0x6f, 0x72, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x12, 0x53, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x75, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x75,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x75, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x59, 0x0a, 0x0e, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e,
-	0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12,
-	0x5f, 0x0a, 0x10, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x73, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x12, 0x53, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x73, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x78, 0x61, 0x6d,
 	0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10,
-	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
-	0x12, 0x4d, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d,
+	0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x73,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x5f, 0x0a, 0x10, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72,
+	0x65, 0x2e, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x4d, 0x0a, 0x0a, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x53, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0c, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x59, 0x0a,
+	0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61,
+	0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x53, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0c, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x5f, 0x0a,
+	0x10, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70,
+	0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x73, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x53,
+	0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c,
-	0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12,
-	0x53, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x47, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6c, 0x62, 0x6f, 0x6f, 0x6c, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
 	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61,
-	0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x75, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x75, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x12, 0x59, 0x0a, 0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65,
+	0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x62, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6c, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x50, 0x0a, 0x0b,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x53,
+	0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c,
+	0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x5c, 0x0a, 0x0f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x4d,
+	0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x12, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72,
+	0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x4d, 0x0a,
+	0x0a, 0x75, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x13, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65,
+	0x2e, 0x55, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x4a, 0x0a, 0x09,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x65, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x4d, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65,
 	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12,
-	0x53, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61,
-	0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x12, 0x5f, 0x0a, 0x10, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x10, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x73, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x53, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x47, 0x0a, 0x08, 0x62, 0x6f,
-	0x6f, 0x6c, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65,
+	0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x65, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x50, 0x0a, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65,
 	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x62, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6c, 0x62,
-	0x6f, 0x6f, 0x6c, 0x12, 0x50, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
-	0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x62, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x75, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x0d, 0x62, 0x6f, 0x6f,
+	0x6c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0d, 0x62, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x62, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x11, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x59, 0x0a, 0x0e,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c,
-	0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5c, 0x0a, 0x0f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x32, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x62, 0x6f, 0x6f, 0x6c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x6f, 0x6f, 0x6c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x6f, 0x6f, 0x6c, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x62, 0x79, 0x74, 0x65, 0x73, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x10, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x34, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x4d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x61, 0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x6e,
-	0x67, 0x69, 0x6e, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
-	0x70, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x75, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x12, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x15, 0x53, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x10, 0x52, 0x03, 0x6b,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x55, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b,
 	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x10, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f,
-	0x49, 0x6e, 0x74, 0x33, 0x32, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x55,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b,
+	0x06, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11,
+	0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x12, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a,
+	0x15, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x10, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x10, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x75, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x07, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x11, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x15, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0f,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
 	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13,
-	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x07, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x3f, 0x0a, 0x11, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x42, 0x6f, 0x6f, 0x6c, 0x62, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x62, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x13, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5e, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x59, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6c,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x59, 0x0a, 0x0f, 0x55, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5b,
+	0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x65, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x0f, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x55, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x62, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x42, 0x6f, 0x6f,
+	0x6c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x62, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x60, 0x0a, 0x15, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45,
 	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x11, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x11, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x43, 0x0a, 0x15, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x73, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x42, 0x6f, 0x6f, 0x6c, 0x62, 0x6f,
-	0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x66, 0x6c,
-	0x6f, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x5e, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x59, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5e, 0x0a,
+	0x14, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x6f, 0x6f, 0x6c, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x59, 0x0a,
-	0x0f, 0x55, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x60, 0x0a,
+	0x15, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x79, 0x74, 0x65, 0x73, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x62, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x82, 0x03, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x47, 0x61, 0x6c,
+	0x6f, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x09, 0x73, 0x6f, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x6f,
+	0x6d, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x08, 0x73, 0x6f, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a,
+	0x07, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73,
+	0x12, 0x40, 0x0a, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6c, 0x69, 0x73,
+	0x74, 0x73, 0x1a, 0x54, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
 	0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x47, 0x61, 0x6c, 0x6f, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc1,
-	0x0c, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x1a, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xd2,
-	0x44, 0x05, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x29, 0x0a, 0x07,
-	0x6f, 0x70, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xd2,
-	0x44, 0x08, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x70,
-	0x74, 0x53, 0x74, 0x72, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc1, 0x0c, 0x0a, 0x0d, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x03, 0x73, 0x74,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xd2, 0x44, 0x05, 0x0a, 0x03, 0x73, 0x74,
+	0x72, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xd2, 0x44, 0x08, 0x0a, 0x06, 0x6f, 0x70,
+	0x74, 0x53, 0x74, 0x72, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x36, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x08, 0xd2, 0x44, 0x05, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x45, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x0b, 0xd2, 0x44, 0x08, 0x0a, 0x06, 0x6f, 0x70, 0x74,
+	0x4d, 0x73, 0x67, 0x48, 0x02, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x4d, 0x73, 0x67, 0x88, 0x01, 0x01,
+	0x12, 0x27, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x0c, 0xd2, 0x44, 0x09, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x07, 0x73, 0x74, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x6d, 0x73, 0x67,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x0c, 0xd2, 0x44, 0x09, 0x0a, 0x07, 0x6d, 0x73,
+	0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x53,
+	0x0a, 0x07, 0x73, 0x74, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b,
+	0xd2, 0x44, 0x08, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x4d, 0x61, 0x70, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x4d, 0x61, 0x70, 0x12, 0x53, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x0b, 0xd2, 0x44, 0x08, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x4d, 0x61, 0x70,
+	0x52, 0x06, 0x6d, 0x73, 0x67, 0x4d, 0x61, 0x70, 0x12, 0x3c, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x74,
+	0x79, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x09, 0xd2, 0x44, 0x06, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d,
+	0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x4b, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x5f, 0x65, 0x6e,
+	0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x0c, 0xd2, 0x44, 0x09, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x45, 0x6e, 0x75, 0x6d, 0x48, 0x03, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x45, 0x6e, 0x75, 0x6d,
+	0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x73, 0x74, 0x72,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x0a, 0x08, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x53, 0x74, 0x72, 0x48, 0x00, 0x52, 0x08, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x74,
+	0x72, 0x12, 0x48, 0x0a, 0x09, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x0c,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x42, 0x08, 0xd2, 0x44, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
-	0x45, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x0b, 0xd2, 0x44,
-	0x08, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x4d, 0x73, 0x67, 0x48, 0x02, 0x52, 0x06, 0x6f, 0x70, 0x74,
-	0x4d, 0x73, 0x67, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0c, 0xd2, 0x44, 0x09, 0x0a, 0x07, 0x73,
-	0x74, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x73, 0x74, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x43, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x0c, 0xd2,
-	0x44, 0x09, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x6d, 0x73, 0x67,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x4d, 0x61, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xd2, 0x44, 0x08, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x4d, 0x61,
-	0x70, 0x52, 0x06, 0x73, 0x74, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x53, 0x0a, 0x07, 0x6d, 0x73, 0x67,
-	0x5f, 0x6d, 0x61, 0x70, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x61,
+	0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x4d, 0x73, 0x67, 0x48,
+	0x00, 0x52, 0x08, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x4d, 0x73, 0x67, 0x12, 0x42, 0x0a, 0x07, 0x73,
+	0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b, 0xd2, 0x44, 0x08, 0x0a,
+	0x06, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x52, 0x06, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x12,
+	0x43, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0xd2,
+	0x44, 0x09, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x52, 0x07, 0x62, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x12, 0x47, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61,
+	0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x0a, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x56, 0x61, 0x6c, 0x52, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x12, 0x4b, 0x0a,
+	0x0a, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x0e, 0xd2, 0x44, 0x0b, 0x0a, 0x09, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x52,
+	0x09, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x12, 0x47, 0x0a, 0x09, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x0a,
+	0x08, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x52, 0x08, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x56, 0x61, 0x6c, 0x12, 0x47, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x12, 0x47, 0x0a, 0x09,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0xd2, 0x44,
+	0x0a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x12, 0x4b, 0x0a, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x76, 0x61, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0xd2, 0x44, 0x0b, 0x0a, 0x09, 0x75, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x52, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x12, 0x4b, 0x0a, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0xd2, 0x44, 0x0b, 0x0a, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x52, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x1a,
+	0x39, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x55, 0x0a, 0x0b, 0x4d, 0x73,
+	0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61,
 	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4d, 0x73,
-	0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xd2, 0x44, 0x08, 0x0a, 0x06,
-	0x6d, 0x73, 0x67, 0x4d, 0x61, 0x70, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4d, 0x61, 0x70, 0x12, 0x3c,
-	0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x09, 0xd2, 0x44, 0x06,
-	0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x4b, 0x0a, 0x08,
-	0x6f, 0x70, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x0c, 0xd2,
-	0x44, 0x09, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x48, 0x03, 0x52, 0x07, 0x6f,
-	0x70, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xd2, 0x44,
-	0x0a, 0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x74, 0x72, 0x48, 0x00, 0x52, 0x08, 0x6f,
-	0x6e, 0x65, 0x6f, 0x66, 0x53, 0x74, 0x72, 0x12, 0x48, 0x0a, 0x09, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
-	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x04, 0x0a, 0x02, 0x6f, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x70, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x70, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x70, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0xd6, 0x04, 0x0a,
+	0x0a, 0x4a, 0x73, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x0d, 0x6a,
+	0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x53,
+	0x74, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x12, 0xd2, 0x44, 0x0f, 0x0a, 0x0b, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x30, 0x01, 0x52, 0x0a, 0x6a, 0x73, 0x6f,
+	0x6e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2e, 0x4a, 0x73,
+	0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x05, 0xd2,
+	0x44, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x4d, 0x61, 0x70,
+	0x12, 0x4b, 0x0a, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61,
 	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x0a, 0x08, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x08, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x4d, 0x73,
-	0x67, 0x12, 0x42, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x0b, 0xd2, 0x44, 0x08, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x52, 0x06, 0x73,
-	0x74, 0x72, 0x56, 0x61, 0x6c, 0x12, 0x43, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61,
-	0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x42, 0x0c, 0xd2, 0x44, 0x09, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
-	0x6c, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x12, 0x47, 0x0a, 0x09, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x0a,
-	0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x52, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x56, 0x61, 0x6c, 0x12, 0x4b, 0x0a, 0x0a, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61,
-	0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0xd2, 0x44, 0x0b, 0x0a, 0x09, 0x64, 0x6f, 0x75, 0x62,
-	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x52, 0x09, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
-	0x12, 0x47, 0x0a, 0x09, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x0a, 0x08, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x52,
-	0x08, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x12, 0x47, 0x0a, 0x09, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x0a, 0x08,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56,
-	0x61, 0x6c, 0x12, 0x47, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
-	0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x12, 0x4b, 0x0a, 0x0a, 0x75,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0xd2,
-	0x44, 0x0b, 0x0a, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x52, 0x09, 0x75,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x12, 0x4b, 0x0a, 0x0a, 0x75, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0xd2, 0x44, 0x0b, 0x0a,
-	0x09, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x52, 0x09, 0x75, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x56, 0x61, 0x6c, 0x1a, 0x39, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x4d, 0x61, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x55, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x04, 0x0a, 0x02, 0x6f, 0x6f, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x6f, 0x70, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x70,
-	0x74, 0x5f, 0x6d, 0x73, 0x67, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x70, 0x74, 0x5f, 0x65, 0x6e,
-	0x75, 0x6d, 0x22, 0xd6, 0x04, 0x0a, 0x0a, 0x4a, 0x73, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x12, 0x29, 0x0a, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x30, 0x01, 0x52,
-	0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0b,
-	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x12, 0xd2,
-	0x44, 0x0f, 0x0a, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x30,
-	0x01, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x57, 0x0a,
-	0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e,
-	0x49, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x4b, 0x0a, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x05, 0xd2, 0x44,
-	0x02, 0x30, 0x01, 0x52, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x30, 0x01, 0x52, 0x0e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x60, 0x0a,
+	0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x70,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x73, 0x6f, 0x6e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x30, 0x01,
+	0x52, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x12,
+	0x27, 0x0a, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6e, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x03, 0x42, 0x07, 0xd2, 0x44, 0x04, 0x28, 0x01, 0x30, 0x01, 0x52, 0x09, 0x6a,
+	0x73, 0x6f, 0x6e, 0x4e, 0x72, 0x53, 0x65, 0x74, 0x1a, 0x3d, 0x0a, 0x0f, 0x4a, 0x73, 0x6f, 0x6e,
+	0x49, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x12, 0x4a, 0x73, 0x6f, 0x6e, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7f, 0x0a, 0x0a, 0x4a, 0x73, 0x6f, 0x6e, 0x4f, 0x6e, 0x65,
+	0x6f, 0x66, 0x73, 0x12, 0x24, 0x0a, 0x09, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x73, 0x74, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x30, 0x01, 0x48, 0x00, 0x52,
+	0x08, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x74, 0x72, 0x12, 0x40, 0x0a, 0x09, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65,
 	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2e, 0x4a, 0x73, 0x6f,
-	0x6e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
-	0x05, 0xd2, 0x44, 0x02, 0x30, 0x01, 0x52, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x4d, 0x61, 0x70, 0x12, 0x27, 0x0a, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6e, 0x72,
-	0x5f, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x42, 0x07, 0xd2, 0x44, 0x04, 0x28,
-	0x01, 0x30, 0x01, 0x52, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x4e, 0x72, 0x53, 0x65, 0x74, 0x1a, 0x3d,
-	0x0a, 0x0f, 0x4a, 0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a,
-	0x12, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x30, 0x01, 0x48,
+	0x00, 0x52, 0x08, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x4d, 0x73, 0x67, 0x42, 0x09, 0x0a, 0x07, 0x6a,
+	0x73, 0x6f, 0x6e, 0x5f, 0x6f, 0x6f, 0x22, 0xe4, 0x04, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x47, 0x61,
+	0x6c, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28, 0x01, 0x52,
+	0x09, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x09, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x05, 0xd2,
+	0x44, 0x02, 0x28, 0x01, 0x52, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x74, 0x12, 0x22,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x05, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x53,
+	0x65, 0x74, 0x12, 0x22, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x73, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x03, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28, 0x01, 0x52, 0x08, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x53, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28,
+	0x01, 0x52, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0a,
+	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04,
+	0x42, 0x05, 0xd2, 0x44, 0x02, 0x28, 0x01, 0x52, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x53,
+	0x65, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x11, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28, 0x01, 0x52, 0x09, 0x73,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x12, 0x42, 0x05, 0xd2, 0x44,
+	0x02, 0x28, 0x01, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x65, 0x74, 0x12, 0x26,
+	0x0a, 0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x07, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x53, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x06, 0x42, 0x05, 0xd2, 0x44, 0x02,
+	0x28, 0x01, 0x52, 0x0a, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x53, 0x65, 0x74, 0x12, 0x28,
+	0x0a, 0x0c, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0f, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x53, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x0c, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x10, 0x42, 0x05,
+	0xd2, 0x44, 0x02, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x53,
+	0x65, 0x74, 0x12, 0x22, 0x0a, 0x09, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x02, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28, 0x01, 0x52, 0x08, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x53, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x5f, 0x73, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x01, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28,
+	0x01, 0x52, 0x09, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x08,
+	0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x05, 0xd2,
+	0x44, 0x02, 0x28, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x22, 0xd1, 0x03,
+	0x0a, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0a, 0xd2, 0x44, 0x07, 0x52, 0x05, 0x63, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0a, 0xd2, 0x44,
+	0x07, 0x52, 0x05, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x48, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x42, 0x0a, 0xd2, 0x44, 0x07, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x52,
+	0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xd2, 0x44, 0x08, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e,
+	0x65, 0x64, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x0b, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0a, 0xd2,
+	0x44, 0x07, 0x52, 0x05, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x5f, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x0a, 0xd2, 0x44, 0x07, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e,
+	0x64, 0x48, 0x00, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x12, 0x49, 0x0a, 0x09,
+	0x64, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x0c,
+	0xd2, 0x44, 0x09, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72,
+	0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x0c, 0xd2, 0x44, 0x09, 0x52, 0x07, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x52, 0x06, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x06, 0x0a, 0x04,
+	0x64, 0x65, 0x61, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x3a, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x8b, 0x02,
+	0x0a, 0x06, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x30,
+	0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74,
+	0x12, 0x41, 0x0a, 0x07, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x53, 0x61,
+	0x76, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x61, 0x76, 0x69,
+	0x6e, 0x67, 0x73, 0x1a, 0x56, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7f, 0x0a, 0x0a, 0x4a,
-	0x73, 0x6f, 0x6e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x73, 0x12, 0x24, 0x0a, 0x09, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0xd2, 0x44,
-	0x02, 0x30, 0x01, 0x48, 0x00, 0x52, 0x08, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x74, 0x72, 0x12,
-	0x40, 0x0a, 0x09, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x05,
-	0xd2, 0x44, 0x02, 0x30, 0x01, 0x48, 0x00, 0x52, 0x08, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x4d, 0x73,
-	0x67, 0x42, 0x09, 0x0a, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6f, 0x6f, 0x2a, 0x3b, 0x0a, 0x09,
-	0x44, 0x69, 0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x49, 0x52,
-	0x54, 0x59, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x52, 0x54, 0x59, 0x4e, 0x45, 0x53,
-	0x53, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x01, 0x42, 0xde, 0x01, 0x0a, 0x16, 0x63, 0x6f,
-	0x6d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x72, 0x65, 0x77, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x6f, 0x64, 0x62, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x4d, 0x58, 0xaa, 0x02, 0x12, 0x45, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12,
-	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3a, 0x3a, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x3b, 0x0a, 0x09, 0x44,
+	0x69, 0x72, 0x74, 0x79, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x49, 0x52, 0x54,
+	0x59, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x52, 0x54, 0x59, 0x4e, 0x45, 0x53, 0x53,
+	0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x01, 0x42, 0xde, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x42, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x72, 0x65, 0x77, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x6f, 0x64, 0x62, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x45, 0x4d, 0x58, 0xaa, 0x02, 0x12, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x1e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x14, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3a, 0x3a, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1958,7 +2729,7 @@ func file_example_message_v1_message_proto_rawDescGZIP() []byte {
 }
 
 var file_example_message_v1_message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_example_message_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_example_message_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_example_message_v1_message_proto_goTypes = []interface{}{
 	(Dirtyness)(0),                 // 0: example.message.v1.Dirtyness
 	(*Engine)(nil),                 // 1: example.message.v1.Engine
@@ -1972,125 +2743,173 @@ var file_example_message_v1_message_proto_goTypes = []interface{}{
 	(*FieldPresence)(nil),          // 9: example.message.v1.FieldPresence
 	(*JsonFields)(nil),             // 10: example.message.v1.JsonFields
 	(*JsonOneofs)(nil),             // 11: example.message.v1.JsonOneofs
-	nil,                            // 12: example.message.v1.Kitchen.FurnitureEntry
-	nil,                            // 13: example.message.v1.Kitchen.CalendarEntry
-	nil,                            // 14: example.message.v1.Kitchen.MappedAnyEntry
-	nil,                            // 15: example.message.v1.Kitchen.MappedFmaskEntry
-	nil,                            // 16: example.message.v1.MapGalore.Int64int64Entry
-	nil,                            // 17: example.message.v1.MapGalore.Uint64uint64Entry
-	nil,                            // 18: example.message.v1.MapGalore.Fixed64fixed64Entry
-	nil,                            // 19: example.message.v1.MapGalore.Sint64sint64Entry
-	nil,                            // 20: example.message.v1.MapGalore.Sfixed64sfixed64Entry
-	nil,                            // 21: example.message.v1.MapGalore.Int32int32Entry
-	nil,                            // 22: example.message.v1.MapGalore.Uint32uint32Entry
-	nil,                            // 23: example.message.v1.MapGalore.Fixed32fixed32Entry
-	nil,                            // 24: example.message.v1.MapGalore.Sint32sint32Entry
-	nil,                            // 25: example.message.v1.MapGalore.Sfixed32sfixed32Entry
-	nil,                            // 26: example.message.v1.MapGalore.StringstringEntry
-	nil,                            // 27: example.message.v1.MapGalore.BoolboolEntry
-	nil,                            // 28: example.message.v1.MapGalore.StringbytesEntry
-	nil,                            // 29: example.message.v1.MapGalore.StringdoubleEntry
-	nil,                            // 30: example.message.v1.MapGalore.StringfloatEntry
-	nil,                            // 31: example.message.v1.MapGalore.StringdurationEntry
-	nil,                            // 32: example.message.v1.MapGalore.StringtimestampEntry
-	nil,                            // 33: example.message.v1.MapGalore.BoolengineEntry
-	nil,                            // 34: example.message.v1.MapGalore.UintengineEntry
-	nil,                            // 35: example.message.v1.FieldPresence.StrMapEntry
-	nil,                            // 36: example.message.v1.FieldPresence.MsgMapEntry
-	nil,                            // 37: example.message.v1.JsonFields.JsonIntMapEntry
-	nil,                            // 38: example.message.v1.JsonFields.JsonEngineMapEntry
-	(*durationpb.Duration)(nil),    // 39: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),  // 40: google.protobuf.Timestamp
-	(*anypb.Any)(nil),              // 41: google.protobuf.Any
-	(*fieldmaskpb.FieldMask)(nil),  // 42: google.protobuf.FieldMask
-	(*structpb.Value)(nil),         // 43: google.protobuf.Value
-	(*wrapperspb.StringValue)(nil), // 44: google.protobuf.StringValue
-	(*wrapperspb.BytesValue)(nil),  // 45: google.protobuf.BytesValue
-	(*wrapperspb.BoolValue)(nil),   // 46: google.protobuf.BoolValue
-	(*wrapperspb.DoubleValue)(nil), // 47: google.protobuf.DoubleValue
-	(*wrapperspb.FloatValue)(nil),  // 48: google.protobuf.FloatValue
-	(*wrapperspb.Int32Value)(nil),  // 49: google.protobuf.Int32Value
-	(*wrapperspb.Int64Value)(nil),  // 50: google.protobuf.Int64Value
-	(*wrapperspb.UInt32Value)(nil), // 51: google.protobuf.UInt32Value
-	(*wrapperspb.UInt64Value)(nil), // 52: google.protobuf.UInt64Value
+	(*SetGalore)(nil),              // 12: example.message.v1.SetGalore
+	(*Priced)(nil),                 // 13: example.message.v1.Priced
+	(*Amount)(nil),                 // 14: example.message.v1.Amount
+	(*Wallet)(nil),                 // 15: example.message.v1.Wallet
+	nil,                            // 16: example.message.v1.Kitchen.FurnitureEntry
+	nil,                            // 17: example.message.v1.Kitchen.CalendarEntry
+	nil,                            // 18: example.message.v1.Kitchen.MappedAnyEntry
+	nil,                            // 19: example.message.v1.Kitchen.MappedFmaskEntry
+	nil,                            // 20: example.message.v1.MapGalore.Int64int64Entry
+	nil,                            // 21: example.message.v1.MapGalore.Uint64uint64Entry
+	nil,                            // 22: example.message.v1.MapGalore.Fixed64fixed64Entry
+	nil,                            // 23: example.message.v1.MapGalore.Sint64sint64Entry
+	nil,                            // 24: example.message.v1.MapGalore.Sfixed64sfixed64Entry
+	nil,                            // 25: example.message.v1.MapGalore.Int32int32Entry
+	nil,                            // 26: example.message.v1.MapGalore.Uint32uint32Entry
+	nil,                            // 27: example.message.v1.MapGalore.Fixed32fixed32Entry
+	nil,                            // 28: example.message.v1.MapGalore.Sint32sint32Entry
+	nil,                            // 29: example.message.v1.MapGalore.Sfixed32sfixed32Entry
+	nil,                            // 30: example.message.v1.MapGalore.StringstringEntry
+	nil,                            // 31: example.message.v1.MapGalore.BoolboolEntry
+	nil,                            // 32: example.message.v1.MapGalore.StringbytesEntry
+	nil,                            // 33: example.message.v1.MapGalore.StringdoubleEntry
+	nil,                            // 34: example.message.v1.MapGalore.StringfloatEntry
+	nil,                            // 35: example.message.v1.MapGalore.StringdurationEntry
+	nil,                            // 36: example.message.v1.MapGalore.StringtimestampEntry
+	nil,                            // 37: example.message.v1.MapGalore.BoolengineEntry
+	nil,                            // 38: example.message.v1.MapGalore.UintengineEntry
+	nil,                            // 39: example.message.v1.MapGalore.Int64enumEntry
+	nil,                            // 40: example.message.v1.MapGalore.StringenumEntry
+	nil,                            // 41: example.message.v1.MapGalore.Uint32bytesEntry
+	nil,                            // 42: example.message.v1.MapGalore.BooltimestampEntry
+	nil,                            // 43: example.message.v1.MapGalore.StringstringvalueEntry
+	nil,                            // 44: example.message.v1.MapGalore.Stringint64valueEntry
+	nil,                            // 45: example.message.v1.MapGalore.StringboolvalueEntry
+	nil,                            // 46: example.message.v1.MapGalore.StringbytesvalueEntry
+	nil,                            // 47: example.message.v1.MapGalore.StringdoublevalueEntry
+	nil,                            // 48: example.message.v1.ValueGalore.ListsEntry
+	nil,                            // 49: example.message.v1.FieldPresence.StrMapEntry
+	nil,                            // 50: example.message.v1.FieldPresence.MsgMapEntry
+	nil,                            // 51: example.message.v1.JsonFields.JsonIntMapEntry
+	nil,                            // 52: example.message.v1.JsonFields.JsonEngineMapEntry
+	nil,                            // 53: example.message.v1.Wallet.SavingsEntry
+	(*durationpb.Duration)(nil),    // 54: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),  // 55: google.protobuf.Timestamp
+	(*anypb.Any)(nil),              // 56: google.protobuf.Any
+	(*fieldmaskpb.FieldMask)(nil),  // 57: google.protobuf.FieldMask
+	(*structpb.Value)(nil),         // 58: google.protobuf.Value
+	(*wrapperspb.StringValue)(nil), // 59: google.protobuf.StringValue
+	(*wrapperspb.BytesValue)(nil),  // 60: google.protobuf.BytesValue
+	(*structpb.Struct)(nil),        // 61: google.protobuf.Struct
+	(*structpb.ListValue)(nil),     // 62: google.protobuf.ListValue
+	(*wrapperspb.BoolValue)(nil),   // 63: google.protobuf.BoolValue
+	(*wrapperspb.DoubleValue)(nil), // 64: google.protobuf.DoubleValue
+	(*wrapperspb.FloatValue)(nil),  // 65: google.protobuf.FloatValue
+	(*wrapperspb.Int32Value)(nil),  // 66: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),  // 67: google.protobuf.Int64Value
+	(*wrapperspb.UInt32Value)(nil), // 68: google.protobuf.UInt32Value
+	(*wrapperspb.UInt64Value)(nil), // 69: google.protobuf.UInt64Value
 }
 var file_example_message_v1_message_proto_depIdxs = []int32{
-	0,  // 0: example.message.v1.Engine.dirtyness:type_name -> example.message.v1.Dirtyness
-	1,  // 1: example.message.v1.Car.engine:type_name -> example.message.v1.Engine
-	0,  // 2: example.message.v1.Kitchen.dirtyness:type_name -> example.message.v1.Dirtyness
-	12, // 3: example.message.v1.Kitchen.furniture:type_name -> example.message.v1.Kitchen.FurnitureEntry
-	13, // 4: example.message.v1.Kitchen.calendar:type_name -> example.message.v1.Kitchen.CalendarEntry
-	1,  // 5: example.message.v1.Kitchen.washer_engine:type_name -> example.message.v1.Engine
-	5,  // 6: example.message.v1.Kitchen.extra_kitchen:type_name -> example.message.v1.Kitchen
-	39, // 7: example.message.v1.Kitchen.timer:type_name -> google.protobuf.Duration
-	40, // 8: example.message.v1.Kitchen.wall_time:type_name -> google.protobuf.Timestamp
-	1,  // 9: example.message.v1.Kitchen.appliance_engines:type_name -> example.message.v1.Engine
-	41, // 10: example.message.v1.Kitchen.some_any:type_name -> google.protobuf.Any
-	42, // 11: example.message.v1.Kitchen.some_mask:type_name -> google.protobuf.FieldMask
-	43, // 12: example.message.v1.Kitchen.some_value:type_name -> google.protobuf.Value
-	44, // 13: example.message.v1.Kitchen.val_str:type_name -> google.protobuf.StringValue
-	45, // 14: example.message.v1.Kitchen.val_bytes:type_name -> google.protobuf.BytesValue
-	40, // 15: example.message.v1.Kitchen.list_of_ts:type_name -> google.protobuf.Timestamp
-	41, // 16: example.message.v1.Kitchen.repeated_any:type_name -> google.protobuf.Any
-	14, // 17: example.message.v1.Kitchen.mapped_any:type_name -> example.message.v1.Kitchen.MappedAnyEntry
-	42, // 18: example.message.v1.Kitchen.repeated_fmask:type_name -> google.protobuf.FieldMask
-	15, // 19: example.message.v1.Kitchen.mapped_fmask:type_name -> example.message.v1.Kitchen.MappedFmaskEntry
-	16, // 20: example.message.v1.MapGalore.int64int64:type_name -> example.message.v1.MapGalore.Int64int64Entry
-	17, // 21: example.message.v1.MapGalore.uint64uint64:type_name -> example.message.v1.MapGalore.Uint64uint64Entry
-	18, // 22: example.message.v1.MapGalore.fixed64fixed64:type_name -> example.message.v1.MapGalore.Fixed64fixed64Entry
-	19, // 23: example.message.v1.MapGalore.sint64sint64:type_name -> example.message.v1.MapGalore.Sint64sint64Entry
-	20, // 24: example.message.v1.MapGalore.sfixed64sfixed64:type_name -> example.message.v1.MapGalore.Sfixed64sfixed64Entry
-	21, // 25: example.message.v1.MapGalore.int32int32:type_name -> example.message.v1.MapGalore.Int32int32Entry
-	22, // 26: example.message.v1.MapGalore.uint32uint32:type_name -> example.message.v1.MapGalore.Uint32uint32Entry
-	23, // 27: example.message.v1.MapGalore.fixed32fixed32:type_name -> example.message.v1.MapGalore.Fixed32fixed32Entry
-	24, // 28: example.message.v1.MapGalore.sint32sint32:type_name -> example.message.v1.MapGalore.Sint32sint32Entry
-	25, // 29: example.message.v1.MapGalore.sfixed32sfixed32:type_name -> example.message.v1.MapGalore.Sfixed32sfixed32Entry
-	26, // 30: example.message.v1.MapGalore.stringstring:type_name -> example.message.v1.MapGalore.StringstringEntry
-	27, // 31: example.message.v1.MapGalore.boolbool:type_name -> example.message.v1.MapGalore.BoolboolEntry
-	28, // 32: example.message.v1.MapGalore.stringbytes:type_name -> example.message.v1.MapGalore.StringbytesEntry
-	29, // 33: example.message.v1.MapGalore.stringdouble:type_name -> example.message.v1.MapGalore.StringdoubleEntry
-	30, // 34: example.message.v1.MapGalore.stringfloat:type_name -> example.message.v1.MapGalore.StringfloatEntry
-	31, // 35: example.message.v1.MapGalore.stringduration:type_name -> example.message.v1.MapGalore.StringdurationEntry
-	32, // 36: example.message.v1.MapGalore.stringtimestamp:type_name -> example.message.v1.MapGalore.StringtimestampEntry
-	33, // 37: example.message.v1.MapGalore.boolengine:type_name -> example.message.v1.MapGalore.BoolengineEntry
-	34, // 38: example.message.v1.MapGalore.uintengine:type_name -> example.message.v1.MapGalore.UintengineEntry
-	43, // 39: example.message.v1.ValueGalore.some_value:type_name -> google.protobuf.Value
-	1,  // 40: example.message.v1.FieldPresence.msg:type_name -> example.message.v1.Engine
-	1,  // 41: example.message.v1.FieldPresence.opt_msg:type_name -> example.message.v1.Engine
-	1,  // 42: example.message.v1.FieldPresence.msg_list:type_name -> example.message.v1.Engine
-	35, // 43: example.message.v1.FieldPresence.str_map:type_name -> example.message.v1.FieldPresence.StrMapEntry
-	36, // 44: example.message.v1.FieldPresence.msg_map:type_name -> example.message.v1.FieldPresence.MsgMapEntry
-	0,  // 45: example.message.v1.FieldPresence.enum:type_name -> example.message.v1.Dirtyness
-	0,  // 46: example.message.v1.FieldPresence.opt_enum:type_name -> example.message.v1.Dirtyness
-	1,  // 47: example.message.v1.FieldPresence.oneof_msg:type_name -> example.message.v1.Engine
-	44, // 48: example.message.v1.FieldPresence.str_val:type_name -> google.protobuf.StringValue
-	46, // 49: example.message.v1.FieldPresence.bool_val:type_name -> google.protobuf.BoolValue
-	45, // 50: example.message.v1.FieldPresence.bytes_val:type_name -> google.protobuf.BytesValue
-	47, // 51: example.message.v1.FieldPresence.double_val:type_name -> google.protobuf.DoubleValue
-	48, // 52: example.message.v1.FieldPresence.float_val:type_name -> google.protobuf.FloatValue
-	49, // 53: example.message.v1.FieldPresence.int32_val:type_name -> google.protobuf.Int32Value
-	50, // 54: example.message.v1.FieldPresence.int64_val:type_name -> google.protobuf.Int64Value
-	51, // 55: example.message.v1.FieldPresence.uint32_val:type_name -> google.protobuf.UInt32Value
-	52, // 56: example.message.v1.FieldPresence.uint64_val:type_name -> google.protobuf.UInt64Value
-	1,  // 57: example.message.v1.JsonFields.json_engine:type_name -> example.message.v1.Engine
-	37, // 58: example.message.v1.JsonFields.json_int_map:type_name -> example.message.v1.JsonFields.JsonIntMapEntry
-	1,  // 59: example.message.v1.JsonFields.json_engine_list:type_name -> example.message.v1.Engine
-	38, // 60: example.message.v1.JsonFields.json_engine_map:type_name -> example.message.v1.JsonFields.JsonEngineMapEntry
-	1,  // 61: example.message.v1.JsonOneofs.oneof_msg:type_name -> example.message.v1.Engine
-	3,  // 62: example.message.v1.Kitchen.FurnitureEntry.value:type_name -> example.message.v1.Appliance
-	41, // 63: example.message.v1.Kitchen.MappedAnyEntry.value:type_name -> google.protobuf.Any
-	42, // 64: example.message.v1.Kitchen.MappedFmaskEntry.value:type_name -> google.protobuf.FieldMask
-	39, // 65: example.message.v1.MapGalore.StringdurationEntry.value:type_name -> google.protobuf.Duration
-	40, // 66: example.message.v1.MapGalore.StringtimestampEntry.value:type_name -> google.protobuf.Timestamp
-	1,  // 67: example.message.v1.MapGalore.BoolengineEntry.value:type_name -> example.message.v1.Engine
-	1,  // 68: example.message.v1.MapGalore.UintengineEntry.value:type_name -> example.message.v1.Engine
-	1,  // 69: example.message.v1.FieldPresence.MsgMapEntry.value:type_name -> example.message.v1.Engine
-	1,  // 70: example.message.v1.JsonFields.JsonEngineMapEntry.value:type_name -> example.message.v1.Engine
-	71, // [71:71] is the sub-list for method output_type
-	71, // [71:71] is the sub-list for method input_type
-	71, // [71:71] is the sub-list for extension type_name
-	71, // [71:71] is the sub-list for extension extendee
-	0,  // [0:71] is the sub-list for field type_name
+	0,   // 0: example.message.v1.Engine.dirtyness:type_name -> example.message.v1.Dirtyness
+	1,   // 1: example.message.v1.Car.engine:type_name -> example.message.v1.Engine
+	0,   // 2: example.message.v1.Kitchen.dirtyness:type_name -> example.message.v1.Dirtyness
+	16,  // 3: example.message.v1.Kitchen.furniture:type_name -> example.message.v1.Kitchen.FurnitureEntry
+	17,  // 4: example.message.v1.Kitchen.calendar:type_name -> example.message.v1.Kitchen.CalendarEntry
+	1,   // 5: example.message.v1.Kitchen.washer_engine:type_name -> example.message.v1.Engine
+	5,   // 6: example.message.v1.Kitchen.extra_kitchen:type_name -> example.message.v1.Kitchen
+	54,  // 7: example.message.v1.Kitchen.timer:type_name -> google.protobuf.Duration
+	55,  // 8: example.message.v1.Kitchen.wall_time:type_name -> google.protobuf.Timestamp
+	1,   // 9: example.message.v1.Kitchen.appliance_engines:type_name -> example.message.v1.Engine
+	56,  // 10: example.message.v1.Kitchen.some_any:type_name -> google.protobuf.Any
+	57,  // 11: example.message.v1.Kitchen.some_mask:type_name -> google.protobuf.FieldMask
+	58,  // 12: example.message.v1.Kitchen.some_value:type_name -> google.protobuf.Value
+	59,  // 13: example.message.v1.Kitchen.val_str:type_name -> google.protobuf.StringValue
+	60,  // 14: example.message.v1.Kitchen.val_bytes:type_name -> google.protobuf.BytesValue
+	55,  // 15: example.message.v1.Kitchen.list_of_ts:type_name -> google.protobuf.Timestamp
+	56,  // 16: example.message.v1.Kitchen.repeated_any:type_name -> google.protobuf.Any
+	18,  // 17: example.message.v1.Kitchen.mapped_any:type_name -> example.message.v1.Kitchen.MappedAnyEntry
+	57,  // 18: example.message.v1.Kitchen.repeated_fmask:type_name -> google.protobuf.FieldMask
+	19,  // 19: example.message.v1.Kitchen.mapped_fmask:type_name -> example.message.v1.Kitchen.MappedFmaskEntry
+	20,  // 20: example.message.v1.MapGalore.int64int64:type_name -> example.message.v1.MapGalore.Int64int64Entry
+	21,  // 21: example.message.v1.MapGalore.uint64uint64:type_name -> example.message.v1.MapGalore.Uint64uint64Entry
+	22,  // 22: example.message.v1.MapGalore.fixed64fixed64:type_name -> example.message.v1.MapGalore.Fixed64fixed64Entry
+	23,  // 23: example.message.v1.MapGalore.sint64sint64:type_name -> example.message.v1.MapGalore.Sint64sint64Entry
+	24,  // 24: example.message.v1.MapGalore.sfixed64sfixed64:type_name -> example.message.v1.MapGalore.Sfixed64sfixed64Entry
+	25,  // 25: example.message.v1.MapGalore.int32int32:type_name -> example.message.v1.MapGalore.Int32int32Entry
+	26,  // 26: example.message.v1.MapGalore.uint32uint32:type_name -> example.message.v1.MapGalore.Uint32uint32Entry
+	27,  // 27: example.message.v1.MapGalore.fixed32fixed32:type_name -> example.message.v1.MapGalore.Fixed32fixed32Entry
+	28,  // 28: example.message.v1.MapGalore.sint32sint32:type_name -> example.message.v1.MapGalore.Sint32sint32Entry
+	29,  // 29: example.message.v1.MapGalore.sfixed32sfixed32:type_name -> example.message.v1.MapGalore.Sfixed32sfixed32Entry
+	30,  // 30: example.message.v1.MapGalore.stringstring:type_name -> example.message.v1.MapGalore.StringstringEntry
+	31,  // 31: example.message.v1.MapGalore.boolbool:type_name -> example.message.v1.MapGalore.BoolboolEntry
+	32,  // 32: example.message.v1.MapGalore.stringbytes:type_name -> example.message.v1.MapGalore.StringbytesEntry
+	33,  // 33: example.message.v1.MapGalore.stringdouble:type_name -> example.message.v1.MapGalore.StringdoubleEntry
+	34,  // 34: example.message.v1.MapGalore.stringfloat:type_name -> example.message.v1.MapGalore.StringfloatEntry
+	35,  // 35: example.message.v1.MapGalore.stringduration:type_name -> example.message.v1.MapGalore.StringdurationEntry
+	36,  // 36: example.message.v1.MapGalore.stringtimestamp:type_name -> example.message.v1.MapGalore.StringtimestampEntry
+	37,  // 37: example.message.v1.MapGalore.boolengine:type_name -> example.message.v1.MapGalore.BoolengineEntry
+	38,  // 38: example.message.v1.MapGalore.uintengine:type_name -> example.message.v1.MapGalore.UintengineEntry
+	39,  // 39: example.message.v1.MapGalore.int64enum:type_name -> example.message.v1.MapGalore.Int64enumEntry
+	40,  // 40: example.message.v1.MapGalore.stringenum:type_name -> example.message.v1.MapGalore.StringenumEntry
+	41,  // 41: example.message.v1.MapGalore.uint32bytes:type_name -> example.message.v1.MapGalore.Uint32bytesEntry
+	42,  // 42: example.message.v1.MapGalore.booltimestamp:type_name -> example.message.v1.MapGalore.BooltimestampEntry
+	43,  // 43: example.message.v1.MapGalore.stringstringvalue:type_name -> example.message.v1.MapGalore.StringstringvalueEntry
+	44,  // 44: example.message.v1.MapGalore.stringint64value:type_name -> example.message.v1.MapGalore.Stringint64valueEntry
+	45,  // 45: example.message.v1.MapGalore.stringboolvalue:type_name -> example.message.v1.MapGalore.StringboolvalueEntry
+	46,  // 46: example.message.v1.MapGalore.stringbytesvalue:type_name -> example.message.v1.MapGalore.StringbytesvalueEntry
+	47,  // 47: example.message.v1.MapGalore.stringdoublevalue:type_name -> example.message.v1.MapGalore.StringdoublevalueEntry
+	58,  // 48: example.message.v1.ValueGalore.some_value:type_name -> google.protobuf.Value
+	61,  // 49: example.message.v1.ValueGalore.some_struct:type_name -> google.protobuf.Struct
+	62,  // 50: example.message.v1.ValueGalore.some_list:type_name -> google.protobuf.ListValue
+	61,  // 51: example.message.v1.ValueGalore.structs:type_name -> google.protobuf.Struct
+	48,  // 52: example.message.v1.ValueGalore.lists:type_name -> example.message.v1.ValueGalore.ListsEntry
+	1,   // 53: example.message.v1.FieldPresence.msg:type_name -> example.message.v1.Engine
+	1,   // 54: example.message.v1.FieldPresence.opt_msg:type_name -> example.message.v1.Engine
+	1,   // 55: example.message.v1.FieldPresence.msg_list:type_name -> example.message.v1.Engine
+	49,  // 56: example.message.v1.FieldPresence.str_map:type_name -> example.message.v1.FieldPresence.StrMapEntry
+	50,  // 57: example.message.v1.FieldPresence.msg_map:type_name -> example.message.v1.FieldPresence.MsgMapEntry
+	0,   // 58: example.message.v1.FieldPresence.enum:type_name -> example.message.v1.Dirtyness
+	0,   // 59: example.message.v1.FieldPresence.opt_enum:type_name -> example.message.v1.Dirtyness
+	1,   // 60: example.message.v1.FieldPresence.oneof_msg:type_name -> example.message.v1.Engine
+	59,  // 61: example.message.v1.FieldPresence.str_val:type_name -> google.protobuf.StringValue
+	63,  // 62: example.message.v1.FieldPresence.bool_val:type_name -> google.protobuf.BoolValue
+	60,  // 63: example.message.v1.FieldPresence.bytes_val:type_name -> google.protobuf.BytesValue
+	64,  // 64: example.message.v1.FieldPresence.double_val:type_name -> google.protobuf.DoubleValue
+	65,  // 65: example.message.v1.FieldPresence.float_val:type_name -> google.protobuf.FloatValue
+	66,  // 66: example.message.v1.FieldPresence.int32_val:type_name -> google.protobuf.Int32Value
+	67,  // 67: example.message.v1.FieldPresence.int64_val:type_name -> google.protobuf.Int64Value
+	68,  // 68: example.message.v1.FieldPresence.uint32_val:type_name -> google.protobuf.UInt32Value
+	69,  // 69: example.message.v1.FieldPresence.uint64_val:type_name -> google.protobuf.UInt64Value
+	1,   // 70: example.message.v1.JsonFields.json_engine:type_name -> example.message.v1.Engine
+	51,  // 71: example.message.v1.JsonFields.json_int_map:type_name -> example.message.v1.JsonFields.JsonIntMapEntry
+	1,   // 72: example.message.v1.JsonFields.json_engine_list:type_name -> example.message.v1.Engine
+	52,  // 73: example.message.v1.JsonFields.json_engine_map:type_name -> example.message.v1.JsonFields.JsonEngineMapEntry
+	1,   // 74: example.message.v1.JsonOneofs.oneof_msg:type_name -> example.message.v1.Engine
+	0,   // 75: example.message.v1.SetGalore.enum_set:type_name -> example.message.v1.Dirtyness
+	1,   // 76: example.message.v1.Priced.engine:type_name -> example.message.v1.Engine
+	1,   // 77: example.message.v1.Priced.trade_in:type_name -> example.message.v1.Engine
+	0,   // 78: example.message.v1.Priced.dirtyness:type_name -> example.message.v1.Dirtyness
+	0,   // 79: example.message.v1.Priced.stains:type_name -> example.message.v1.Dirtyness
+	14,  // 80: example.message.v1.Wallet.balance:type_name -> example.message.v1.Amount
+	14,  // 81: example.message.v1.Wallet.spent:type_name -> example.message.v1.Amount
+	53,  // 82: example.message.v1.Wallet.savings:type_name -> example.message.v1.Wallet.SavingsEntry
+	3,   // 83: example.message.v1.Kitchen.FurnitureEntry.value:type_name -> example.message.v1.Appliance
+	56,  // 84: example.message.v1.Kitchen.MappedAnyEntry.value:type_name -> google.protobuf.Any
+	57,  // 85: example.message.v1.Kitchen.MappedFmaskEntry.value:type_name -> google.protobuf.FieldMask
+	54,  // 86: example.message.v1.MapGalore.StringdurationEntry.value:type_name -> google.protobuf.Duration
+	55,  // 87: example.message.v1.MapGalore.StringtimestampEntry.value:type_name -> google.protobuf.Timestamp
+	1,   // 88: example.message.v1.MapGalore.BoolengineEntry.value:type_name -> example.message.v1.Engine
+	1,   // 89: example.message.v1.MapGalore.UintengineEntry.value:type_name -> example.message.v1.Engine
+	0,   // 90: example.message.v1.MapGalore.Int64enumEntry.value:type_name -> example.message.v1.Dirtyness
+	0,   // 91: example.message.v1.MapGalore.StringenumEntry.value:type_name -> example.message.v1.Dirtyness
+	55,  // 92: example.message.v1.MapGalore.BooltimestampEntry.value:type_name -> google.protobuf.Timestamp
+	59,  // 93: example.message.v1.MapGalore.StringstringvalueEntry.value:type_name -> google.protobuf.StringValue
+	67,  // 94: example.message.v1.MapGalore.Stringint64valueEntry.value:type_name -> google.protobuf.Int64Value
+	63,  // 95: example.message.v1.MapGalore.StringboolvalueEntry.value:type_name -> google.protobuf.BoolValue
+	60,  // 96: example.message.v1.MapGalore.StringbytesvalueEntry.value:type_name -> google.protobuf.BytesValue
+	64,  // 97: example.message.v1.MapGalore.StringdoublevalueEntry.value:type_name -> google.protobuf.DoubleValue
+	62,  // 98: example.message.v1.ValueGalore.ListsEntry.value:type_name -> google.protobuf.ListValue
+	1,   // 99: example.message.v1.FieldPresence.MsgMapEntry.value:type_name -> example.message.v1.Engine
+	1,   // 100: example.message.v1.JsonFields.JsonEngineMapEntry.value:type_name -> example.message.v1.Engine
+	14,  // 101: example.message.v1.Wallet.SavingsEntry.value:type_name -> example.message.v1.Amount
+	102, // [102:102] is the sub-list for method output_type
+	102, // [102:102] is the sub-list for method input_type
+	102, // [102:102] is the sub-list for extension type_name
+	102, // [102:102] is the sub-list for extension extendee
+	0,   // [0:102] is the sub-list for field type_name
 }
 
 func init() { file_example_message_v1_message_proto_init() }
@@ -2231,6 +3050,54 @@ func file_example_message_v1_message_proto_init() {
 				return nil
 			}
 		}
+		file_example_message_v1_message_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGalore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_message_v1_message_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Priced); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_message_v1_message_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Amount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_message_v1_message_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Wallet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_example_message_v1_message_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	file_example_message_v1_message_proto_msgTypes[8].OneofWrappers = []interface{}{
@@ -2241,13 +3108,17 @@ func file_example_message_v1_message_proto_init() {
 		(*JsonOneofs_OneofStr)(nil),
 		(*JsonOneofs_OneofMsg)(nil),
 	}
+	file_example_message_v1_message_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*Priced_FixedPrice)(nil),
+		(*Priced_TradeIn)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_example_message_v1_message_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   38,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -7,6 +7,8 @@ import (
 	types "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	ddb "github.com/crewlinker/protoc-gen-dynamodb/ddb"
 	v1 "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+	v11 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/common/v1"
+	v12 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/foreign/v1"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -27,9 +29,68 @@ func (x *OtherKitchen) MarshalDynamoItem() (m map[string]types.AttributeValue, e
 		}
 		m["17"] = m17
 	}
+	if x.Address != nil {
+		m18, err := ddb.MarshalMessage(x.GetAddress(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Address': %w", err)
+		}
+		m["18"] = m18
+	}
+	if len(x.Addresses) != 0 {
+		m["19"], err = ddb.MarshalRepeatedMessage(x.Addresses, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal repeated message field 'Addresses': %w", err)
+		}
+	}
+	if len(x.AddressesByName) != 0 {
+		m["20"], err = ddb.MarshalMappedMessage(x.AddressesByName, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mapped message field 'AddressesByName': %w", err)
+		}
+	}
+	if x.Birthday != nil {
+		m21, err := ddb.MarshalMessage(x.GetBirthday(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Birthday': %w", err)
+		}
+		m["21"] = m21
+	}
+	if len(x.Holidays) != 0 {
+		m["22"], err = ddb.MarshalRepeatedMessage(x.Holidays, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal repeated message field 'Holidays': %w", err)
+		}
+	}
+	if len(x.DatesByName) != 0 {
+		m["23"], err = ddb.MarshalMappedMessage(x.DatesByName, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mapped message field 'DatesByName': %w", err)
+		}
+	}
+	if x.Country != 0 {
+		m["24"], err = ddb.Marshal(x.GetCountry(), ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field 'Country': %w", err)
+		}
+	}
+	if len(x.Visited) != 0 {
+		m["25"], err = ddb.MarshalSet(x.Visited, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set item of field 'Visited': %w", err)
+		}
+	}
 	return m, nil
 }
 
+// DynamoItemSize returns the size of the marshalled item, as accounted for by DynamoDB
+func (x *OtherKitchen) DynamoItemSize() (int, error) {
+	m, err := x.MarshalDynamoItem()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return ddb.ItemSize(m), nil
+}
+
 // UnmarshalDynamoItem unmarshals data from a dynamodb attribute map
 func (x *OtherKitchen) UnmarshalDynamoItem(m map[string]types.AttributeValue) (err error) {
 	if m["16"] != nil {
@@ -46,5 +107,51 @@ func (x *OtherKitchen) UnmarshalDynamoItem(m map[string]types.AttributeValue) (e
 			return fmt.Errorf("failed to unmarshal field 'OtherTimer': %w", err)
 		}
 	}
+	if m["18"] != nil {
+		x.Address = new(v11.Address)
+		err = ddb.UnmarshalMessage(m["18"], x.Address, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'Address': %w", err)
+		}
+	}
+	if m["19"] != nil {
+		x.Addresses, err = ddb.UnmarshalRepeatedMessage[v11.Address](m["19"], ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'Addresses': %w", err)
+		}
+	}
+	if m["20"] != nil {
+		x.AddressesByName, err = ddb.UnmarshalMappedMessage[string, v11.Address](m["20"], ddb.StringMapKey, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'AddressesByName': %w", err)
+		}
+	}
+	if m["21"] != nil {
+		x.Birthday = new(v12.Date)
+		err = ddb.UnmarshalMessage(m["21"], x.Birthday, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal field 'Birthday': %w", err)
+		}
+	}
+	if m["22"] != nil {
+		x.Holidays, err = ddb.UnmarshalRepeatedMessage[v12.Date](m["22"], ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'Holidays': %w", err)
+		}
+	}
+	if m["23"] != nil {
+		x.DatesByName, err = ddb.UnmarshalMappedMessage[string, v12.Date](m["23"], ddb.StringMapKey, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal repeated message field 'DatesByName': %w", err)
+		}
+	}
+	err = ddb.Unmarshal(m["24"], &x.Country, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Country': %w", err)
+	}
+	err = ddb.Unmarshal(m["25"], &x.Visited, ddb.Embed(v1.Encoding_ENCODING_DYNAMO))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal field 'Visited': %w", err)
+	}
 	return nil
 }
@@ -7,6 +7,9 @@
 package messagev1
 
 import (
+	_ "github.com/crewlinker/protoc-gen-dynamodb/proto/ddb/v1"
+	v1 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/common/v1"
+	v11 "github.com/crewlinker/protoc-gen-dynamodb/proto/example/foreign/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
@@ -32,6 +35,22 @@ type OtherKitchen struct {
 	AnotherKitchen *Kitchen `protobuf:"bytes,16,opt,name=another_kitchen,json=anotherKitchen,proto3" json:"another_kitchen,omitempty"`
 	// well-known imported messages
 	OtherTimer *durationpb.Duration `protobuf:"bytes,17,opt,name=other_timer,json=otherTimer,proto3" json:"other_timer,omitempty"`
+	// message from another package
+	Address *v1.Address `protobuf:"bytes,18,opt,name=address,proto3" json:"address,omitempty"`
+	// list of messages from another package
+	Addresses []*v1.Address `protobuf:"bytes,19,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// map of messages from another package
+	AddressesByName map[string]*v1.Address `protobuf:"bytes,20,rep,name=addresses_by_name,json=addressesByName,proto3" json:"addresses_by_name,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// message from a package without generated path building
+	Birthday *v11.Date `protobuf:"bytes,21,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	// list of messages from a package without generated path building
+	Holidays []*v11.Date `protobuf:"bytes,22,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	// map of messages from a package without generated path building
+	DatesByName map[string]*v11.Date `protobuf:"bytes,23,rep,name=dates_by_name,json=datesByName,proto3" json:"dates_by_name,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// enum from another package
+	Country v1.Country `protobuf:"varint,24,opt,name=country,proto3,enum=example.common.v1.Country" json:"country,omitempty"`
+	// set of enums from another package
+	Visited []v1.Country `protobuf:"varint,25,rep,packed,name=visited,proto3,enum=example.common.v1.Country" json:"visited,omitempty"`
 }
 
 func (x *OtherKitchen) Reset() {
@@ -80,6 +99,62 @@ func (x *OtherKitchen) GetOtherTimer() *durationpb.Duration {
 	return nil
 }
 
+func (x *OtherKitchen) GetAddress() *v1.Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *OtherKitchen) GetAddresses() []*v1.Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *OtherKitchen) GetAddressesByName() map[string]*v1.Address {
+	if x != nil {
+		return x.AddressesByName
+	}
+	return nil
+}
+
+func (x *OtherKitchen) GetBirthday() *v11.Date {
+	if x != nil {
+		return x.Birthday
+	}
+	return nil
+}
+
+func (x *OtherKitchen) GetHolidays() []*v11.Date {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+func (x *OtherKitchen) GetDatesByName() map[string]*v11.Date {
+	if x != nil {
+		return x.DatesByName
+	}
+	return nil
+}
+
+func (x *OtherKitchen) GetCountry() v1.Country {
+	if x != nil {
+		return x.Country
+	}
+	return v1.Country(0)
+}
+
+func (x *OtherKitchen) GetVisited() []v1.Country {
+	if x != nil {
+		return x.Visited
+	}
+	return nil
+}
+
 var File_example_message_v1_other_proto protoreflect.FileDescriptor
 
 var file_example_message_v1_other_proto_rawDesc = []byte{
@@ -88,32 +163,82 @@ var file_example_message_v1_other_proto_rawDesc = []byte{
 	0x12, 0x12, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x0c, 0x4f, 0x74, 0x68, 0x65, 0x72,
-	0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x12, 0x44, 0x0a, 0x0f, 0x61, 0x6e, 0x6f, 0x74, 0x68,
-	0x65, 0x72, 0x5f, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x52, 0x0e, 0x61,
-	0x6e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x12, 0x3a, 0x0a,
-	0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f,
-	0x74, 0x68, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x42, 0xdc, 0x01, 0x0a, 0x16, 0x63, 0x6f,
-	0x6d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x72, 0x65, 0x77, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x6f, 0x64, 0x62, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x45, 0x4d, 0x58, 0xaa, 0x02, 0x12, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x45, 0x78,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x64, 0x64, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd3,
+	0x06, 0x0a, 0x0c, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x12,
+	0x44, 0x0a, 0x0f, 0x61, 0x6e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69,
+	0x74, 0x63, 0x68, 0x65, 0x6e, 0x52, 0x0e, 0x61, 0x6e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x4b, 0x69,
+	0x74, 0x63, 0x68, 0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x72, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x61, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x5f, 0x62,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x42, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65,
+	0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x68, 0x6f,
+	0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73,
+	0x12, 0x55, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x74, 0x68,
+	0x65, 0x72, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x73, 0x42,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3b, 0x0a,
+	0x07, 0x76, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x28,
+	0x01, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x1a, 0x5e, 0x0a, 0x14, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x10, 0x44, 0x61,
+	0x74, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0xdc, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x42,
+	0x0a, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x72, 0x65, 0x77, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x6f, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x76,
+	0x31, 0x3b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x4d,
+	0x58, 0xaa, 0x02, 0x12, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x45, 0x78,
 	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x1e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x14, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3a, 0x3a, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3a, 0x3a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -128,20 +253,35 @@ func file_example_message_v1_other_proto_rawDescGZIP() []byte {
 	return file_example_message_v1_other_proto_rawDescData
 }
 
-var file_example_message_v1_other_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_example_message_v1_other_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_example_message_v1_other_proto_goTypes = []interface{}{
 	(*OtherKitchen)(nil),        // 0: example.message.v1.OtherKitchen
-	(*Kitchen)(nil),             // 1: example.message.v1.Kitchen
-	(*durationpb.Duration)(nil), // 2: google.protobuf.Duration
+	nil,                         // 1: example.message.v1.OtherKitchen.AddressesByNameEntry
+	nil,                         // 2: example.message.v1.OtherKitchen.DatesByNameEntry
+	(*Kitchen)(nil),             // 3: example.message.v1.Kitchen
+	(*durationpb.Duration)(nil), // 4: google.protobuf.Duration
+	(*v1.Address)(nil),          // 5: example.common.v1.Address
+	(*v11.Date)(nil),            // 6: example.foreign.v1.Date
+	(v1.Country)(0),             // 7: example.common.v1.Country
 }
 var file_example_message_v1_other_proto_depIdxs = []int32{
-	1, // 0: example.message.v1.OtherKitchen.another_kitchen:type_name -> example.message.v1.Kitchen
-	2, // 1: example.message.v1.OtherKitchen.other_timer:type_name -> google.protobuf.Duration
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3,  // 0: example.message.v1.OtherKitchen.another_kitchen:type_name -> example.message.v1.Kitchen
+	4,  // 1: example.message.v1.OtherKitchen.other_timer:type_name -> google.protobuf.Duration
+	5,  // 2: example.message.v1.OtherKitchen.address:type_name -> example.common.v1.Address
+	5,  // 3: example.message.v1.OtherKitchen.addresses:type_name -> example.common.v1.Address
+	1,  // 4: example.message.v1.OtherKitchen.addresses_by_name:type_name -> example.message.v1.OtherKitchen.AddressesByNameEntry
+	6,  // 5: example.message.v1.OtherKitchen.birthday:type_name -> example.foreign.v1.Date
+	6,  // 6: example.message.v1.OtherKitchen.holidays:type_name -> example.foreign.v1.Date
+	2,  // 7: example.message.v1.OtherKitchen.dates_by_name:type_name -> example.message.v1.OtherKitchen.DatesByNameEntry
+	7,  // 8: example.message.v1.OtherKitchen.country:type_name -> example.common.v1.Country
+	7,  // 9: example.message.v1.OtherKitchen.visited:type_name -> example.common.v1.Country
+	5,  // 10: example.message.v1.OtherKitchen.AddressesByNameEntry.value:type_name -> example.common.v1.Address
+	6,  // 11: example.message.v1.OtherKitchen.DatesByNameEntry.value:type_name -> example.foreign.v1.Date
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_example_message_v1_other_proto_init() }
@@ -170,7 +310,7 @@ func file_example_message_v1_other_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_example_message_v1_other_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},